@@ -0,0 +1,156 @@
+package gocardless
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// BankDetails holds the details of a bank account to be looked up or checked. Details may be supplied either as an
+// IBAN, or as local details using the AccountNumber, BranchCode and BankCode fields along with the CountryCode
+type BankDetails struct {
+	// AccountNumber is the bank account number.
+	AccountNumber string `json:"account_number,omitempty"`
+	// BankCode is the bank code.
+	BankCode string `json:"bank_code,omitempty"`
+	// BranchCode is the branch code, or sort code in the UK.
+	BranchCode string `json:"branch_code,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code. Required when local details are supplied.
+	CountryCode string `json:"country_code,omitempty"`
+	// IBAN is the international bank account number.
+	IBAN string `json:"iban,omitempty"`
+}
+
+// BankDetailsLookup is the result of looking up a set of BankDetails
+type BankDetailsLookup struct {
+	// AvailableDebitSchemes are the Direct Debit schemes which may be used with the bank account.
+	AvailableDebitSchemes []string `json:"available_debit_schemes"`
+	// BankName is the name of the bank the account is held with.
+	BankName string `json:"bank_name"`
+	// BIC is the ISO 9362 SWIFT BIC of the bank.
+	BIC string `json:"bic"`
+}
+
+// localDetailLengths holds the permitted number of digits in each local detail, by country. A detail which is absent
+// from the map for a country is not checked
+var localDetailLengths = map[string]map[string][2]int{
+	`AU`: {`branch_code`: {6, 6}, `account_number`: {5, 9}},
+	`CA`: {`bank_code`: {3, 3}, `branch_code`: {5, 5}, `account_number`: {7, 12}},
+	`GB`: {`branch_code`: {6, 6}, `account_number`: {6, 8}},
+	`NZ`: {`bank_code`: {2, 2}, `branch_code`: {4, 4}, `account_number`: {7, 7}},
+	`US`: {`bank_code`: {9, 9}, `account_number`: {1, 17}},
+}
+
+// ibanLengths holds the length of a valid IBAN for the countries in which GoCardless collects payments
+var ibanLengths = map[string]int{
+	`AT`: 20, `BE`: 16, `CY`: 28, `DE`: 22, `DK`: 18, `EE`: 20, `ES`: 24, `FI`: 18, `FR`: 27, `GB`: 22, `GR`: 27,
+	`IE`: 22, `IT`: 27, `LT`: 20, `LU`: 20, `LV`: 21, `MC`: 27, `MT`: 31, `NL`: 18, `NO`: 15, `PT`: 25, `SE`: 24,
+	`SI`: 19, `SK`: 24, `SM`: 27,
+}
+
+// ValidateBankDetails checks the details for obvious mistakes before they are sent to the API, such as an IBAN with
+// an invalid checksum or a UK sort code of the wrong length. Any problems, including nil details, are returned as a
+// ValidationError with the same Field and RequestPointer values the API would have produced for a bank details lookup
+func ValidateBankDetails(details *BankDetails) error {
+	if details == nil {
+		return newValidationError(&ErrorDetail{
+			Message:        `must be provided`,
+			Field:          `bank_details_lookups`,
+			RequestPointer: `/bank_details_lookups`,
+		})
+	}
+
+	var problems []*ErrorDetail
+	invalid := func(field, message string) {
+		problems = append(problems, &ErrorDetail{
+			Message:        message,
+			Field:          field,
+			RequestPointer: fmt.Sprintf(`/bank_details_lookups/%s`, field),
+		})
+	}
+
+	if details.IBAN != `` {
+		if message := checkIBAN(details.IBAN); message != `` {
+			invalid(`iban`, message)
+		}
+	} else if details.CountryCode == `` {
+		invalid(`country_code`, `is required when an IBAN is not provided`)
+	} else {
+		lengths := localDetailLengths[strings.ToUpper(details.CountryCode)]
+		for _, field := range []struct{ name, value string }{
+			{`account_number`, details.AccountNumber},
+			{`bank_code`, details.BankCode},
+			{`branch_code`, details.BranchCode},
+		} {
+			limits, ok := lengths[field.name]
+			if !ok {
+				continue
+			}
+			for _, message := range checkDigits(field.value, limits[0], limits[1]) {
+				invalid(field.name, message)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return newValidationError(problems...)
+	}
+	return nil
+}
+
+// checkDigits returns the problems with a local detail which must contain between min and max digits. Spaces and
+// hyphens, as commonly used in UK sort codes, are ignored
+func checkDigits(value string, min, max int) []string {
+	value = strings.NewReplacer(` `, ``, `-`, ``).Replace(value)
+
+	var problems []string
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			problems = append(problems, `must be a number`)
+			break
+		}
+	}
+
+	switch {
+	case len(value) == 0:
+		problems = append(problems, `is required`)
+	case min == max && len(value) != min:
+		problems = append(problems, fmt.Sprintf(`is the wrong length (should be %d characters)`, min))
+	case len(value) < min:
+		problems = append(problems, fmt.Sprintf(`is too short (minimum is %d characters)`, min))
+	case len(value) > max:
+		problems = append(problems, fmt.Sprintf(`is too long (maximum is %d characters)`, max))
+	}
+	return problems
+}
+
+// checkIBAN returns a description of the problem with iban, or an empty string when it is valid. The length is
+// checked for known countries, and the ISO 7064 mod 97 checksum for all countries
+func checkIBAN(iban string) string {
+	iban = strings.ToUpper(strings.Replace(iban, ` `, ``, -1))
+	if len(iban) < 15 || len(iban) > 34 {
+		return `is the wrong length`
+	}
+	if length, ok := ibanLengths[iban[:2]]; ok && len(iban) != length {
+		return fmt.Sprintf(`is the wrong length (should be %d characters)`, length)
+	}
+
+	// Move the country code and check digits to the end, and replace each letter with two digits (A = 10, B = 11, …)
+	var numeric strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		switch {
+		case r >= '0' && r <= '9':
+			numeric.WriteRune(r)
+		case r >= 'A' && r <= 'Z':
+			fmt.Fprintf(&numeric, `%d`, r-'A'+10)
+		default:
+			return `must only contain letters and numbers`
+		}
+	}
+
+	value, _ := new(big.Int).SetString(numeric.String(), 10)
+	if new(big.Int).Mod(value, big.NewInt(97)).Int64() != 1 {
+		return `is invalid`
+	}
+	return ``
+}
@@ -0,0 +1,120 @@
+package gocardless
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateBankDetails(t *testing.T) {
+	Convey(`Given I have a valid IBAN`, t, func() {
+		details := &BankDetails{IBAN: `GB60 BARC 2000 0055 7799 11`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then the error will be nil`, func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey(`Given I have an IBAN with an incorrect checksum`, t, func() {
+		details := &BankDetails{IBAN: `GB61BARC20000055779911`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then a validation error will be returned for the IBAN`, func() {
+				So(err, ShouldHaveSameTypeAs, ValidationError{})
+				gcErr := err.(ValidationError).Err
+				So(gcErr.Type, ShouldEqual, ValidationFailedType)
+				So(gcErr.Details, ShouldHaveLength, 1)
+				So(gcErr.Details[0].Field, ShouldEqual, `iban`)
+				So(gcErr.Details[0].RequestPointer, ShouldEqual, `/bank_details_lookups/iban`)
+				So(gcErr.Details[0].Message, ShouldEqual, `is invalid`)
+			})
+		})
+	})
+
+	Convey(`Given I have an IBAN of the wrong length for its country`, t, func() {
+		details := &BankDetails{IBAN: `DE8937040044053201300`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then the error will describe the expected length`, func() {
+				So(err, ShouldNotBeNil)
+				So(err.(ValidationError).Err.Details[0].Message, ShouldEqual, `is the wrong length (should be 22 characters)`)
+			})
+		})
+	})
+
+	Convey(`Given I have valid UK local details`, t, func() {
+		details := &BankDetails{AccountNumber: `55779911`, BranchCode: `20-00-00`, CountryCode: `GB`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then the error will be nil`, func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey(`Given I have UK local details with a short sort code and a non-numeric account number`, t, func() {
+		details := &BankDetails{AccountNumber: `5577991X`, BranchCode: `20000`, CountryCode: `GB`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then each problem will be reported against its field`, func() {
+				So(err, ShouldNotBeNil)
+				problems := err.(ValidationError).Err.Details
+				So(problems, ShouldHaveLength, 2)
+				So(problems[0].Field, ShouldEqual, `account_number`)
+				So(problems[0].Message, ShouldEqual, `must be a number`)
+				So(problems[1].Field, ShouldEqual, `branch_code`)
+				So(problems[1].RequestPointer, ShouldEqual, `/bank_details_lookups/branch_code`)
+				So(problems[1].Message, ShouldEqual, `is the wrong length (should be 6 characters)`)
+			})
+		})
+	})
+
+	Convey(`Given I have local details without a country code`, t, func() {
+		details := &BankDetails{AccountNumber: `55779911`, BranchCode: `200000`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then the country code will be reported as missing`, func() {
+				So(err, ShouldNotBeNil)
+				So(err.(ValidationError).Err.Details[0].Field, ShouldEqual, `country_code`)
+			})
+		})
+	})
+
+	Convey(`Given I have local details for a country without known formats`, t, func() {
+		details := &BankDetails{AccountNumber: `1234`, BankCode: `X`, CountryCode: `SE`}
+
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(details)
+
+			Convey(`Then the details will be left for the API to check`, func() {
+				So(err, ShouldBeNil)
+			})
+		})
+	})
+	Convey(`Given I have nil details`, t, func() {
+		Convey(`When I validate the details`, func() {
+			err := ValidateBankDetails(nil)
+
+			Convey(`Then a validation error will be returned for the lookup`, func() {
+				So(err, ShouldHaveSameTypeAs, ValidationError{})
+				gcErr := err.(ValidationError).Err
+				So(gcErr.Details, ShouldHaveLength, 1)
+				So(gcErr.Details[0].RequestPointer, ShouldEqual, `/bank_details_lookups`)
+			})
+		})
+	})
+}
@@ -0,0 +1,272 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// BillingRequestStatus describes the state of a BillingRequest
+type BillingRequestStatus string
+
+const (
+	// BillingRequestPending means the billing request has been created, but actions are still required
+	BillingRequestPending BillingRequestStatus = `pending`
+	// BillingRequestReadyToFulfil means all required actions have been completed and the request may be fulfilled
+	BillingRequestReadyToFulfil BillingRequestStatus = `ready_to_fulfil`
+	// BillingRequestFulfilling means the billing request is being fulfilled
+	BillingRequestFulfilling BillingRequestStatus = `fulfilling`
+	// BillingRequestFulfilled means the mandate and payment requested have been created
+	BillingRequestFulfilled BillingRequestStatus = `fulfilled`
+	// BillingRequestCancelled means the billing request has been cancelled and can no longer be used
+	BillingRequestCancelled BillingRequestStatus = `cancelled`
+)
+
+// BillingRequest is used to collect a mandate, a payment or both from a payer in a single flow, including through
+// Instant Bank Pay. The Actions describe what must be completed before the request can be fulfilled
+type BillingRequest struct {
+	// ID is a unique identifier, beginning with “BRQ”.
+	ID string `json:"id,omitempty"`
+	// Actions are the steps that must be completed before the billing request can be fulfilled, as returned by the
+	// API.
+	Actions []*BillingRequestAction `json:"actions,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the billing request was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// FallbackEnabled allows the payer to fall back from Instant Bank Pay to a Direct Debit mandate when true.
+	FallbackEnabled bool `json:"fallback_enabled,omitempty"`
+	// FallbackOccurred is true when the payer has fallen back to a mandate, as returned by the API.
+	FallbackOccurred bool `json:"fallback_occurred,omitempty"`
+	// MandateRequest describes the mandate to be created when the billing request is fulfilled.
+	MandateRequest *BillingRequestMandateRequest `json:"mandate_request,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// PaymentRequest describes the payment to be collected when the billing request is fulfilled.
+	PaymentRequest *BillingRequestPaymentRequest `json:"payment_request,omitempty"`
+	// Resources holds the details collected from the payer so far, as returned by the API.
+	Resources *BillingRequestResources `json:"resources,omitempty"`
+	// Status is the current state of the billing request.
+	Status BillingRequestStatus `json:"status,omitempty"`
+	// Links holds the IDs of the resources the billing request is associated with
+	Links *BillingRequestLinks `json:"links,omitempty"`
+}
+
+// BillingRequestMandateRequest describes the mandate a BillingRequest will create
+type BillingRequestMandateRequest struct {
+	// Currency is the ISO 4217 currency code of the mandate.
+	Currency string `json:"currency,omitempty"`
+	// Description is a human-readable description of the mandate, shown to the payer.
+	Description string `json:"description,omitempty"`
+	// Metadata is a key-value store of custom data, copied to the mandate once it is created.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Scheme is the Direct Debit scheme of the mandate. If left blank it is chosen from the currency.
+	Scheme string `json:"scheme,omitempty"`
+	// Verify controls whether the payer’s bank account is verified, one of “minimum”, “recommended”, “when_available”
+	// or “always”.
+	Verify string `json:"verify,omitempty"`
+	// Links holds the ID of the mandate once it has been created, as returned by the API
+	Links *BillingRequestMandateRequestLinks `json:"links,omitempty"`
+}
+
+// BillingRequestMandateRequestLinks holds the IDs of the resources linked to a BillingRequestMandateRequest
+type BillingRequestMandateRequestLinks struct {
+	// Mandate is the ID of the mandate created when the billing request was fulfilled.
+	Mandate string `json:"mandate,omitempty"`
+}
+
+// BillingRequestPaymentRequest describes the payment a BillingRequest will collect
+type BillingRequestPaymentRequest struct {
+	// Amount is the amount in minor unit (e.g. pence in GBP, cents in EUR).
+	Amount int `json:"amount,omitempty"`
+	// AppFee is the amount to be deducted from the payment as the OAuth app’s fee, in minor unit.
+	AppFee int `json:"app_fee,omitempty"`
+	// Currency is the ISO 4217 currency code of the payment.
+	Currency string `json:"currency,omitempty"`
+	// Description is a human-readable description of the payment, shown to the payer.
+	Description string `json:"description,omitempty"`
+	// Metadata is a key-value store of custom data, copied to the payment once it is created.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Scheme is the scheme used to collect the payment, such as “faster_payments” for Instant Bank Pay.
+	Scheme string `json:"scheme,omitempty"`
+	// Links holds the ID of the payment once it has been created, as returned by the API
+	Links *BillingRequestPaymentRequestLinks `json:"links,omitempty"`
+}
+
+// BillingRequestPaymentRequestLinks holds the IDs of the resources linked to a BillingRequestPaymentRequest
+type BillingRequestPaymentRequestLinks struct {
+	// Payment is the ID of the payment created when the billing request was fulfilled.
+	Payment string `json:"payment,omitempty"`
+}
+
+// BillingRequestAction is a step which must be completed before a BillingRequest can be fulfilled
+type BillingRequestAction struct {
+	// Type is the action endpoint which completes this step, such as “collect_customer_details”.
+	Type string `json:"type"`
+	// Required is true when the action must be completed before the billing request can be fulfilled.
+	Required bool `json:"required"`
+	// Status is either “pending” or “completed”.
+	Status string `json:"status"`
+	// AvailableCurrencies lists the currencies the payer may choose from, for the choose_currency action.
+	AvailableCurrencies []string `json:"available_currencies,omitempty"`
+	// CompletesActions lists the types of the other actions completed alongside this one.
+	CompletesActions []string `json:"completes_actions,omitempty"`
+	// RequiresActions lists the types of the actions which must be completed before this one.
+	RequiresActions []string `json:"requires_actions,omitempty"`
+}
+
+// BillingRequestResources holds the payer details which have been collected by a BillingRequest
+type BillingRequestResources struct {
+	// Customer is the customer the billing request is for.
+	Customer *Customer `json:"customer,omitempty"`
+	// CustomerBankAccount is the bank account collected from the payer.
+	CustomerBankAccount *CustomerBankAccount `json:"customer_bank_account,omitempty"`
+	// CustomerBillingDetail is the billing address collected from the payer.
+	CustomerBillingDetail *CustomerBillingDetail `json:"customer_billing_detail,omitempty"`
+}
+
+// CustomerBillingDetail holds the billing address of a payer, collected as part of a BillingRequest
+type CustomerBillingDetail struct {
+	// ID is a unique identifier, beginning with “CU”.
+	ID string `json:"id,omitempty"`
+	// AddressLine1 is the first line of the payer’s address.
+	AddressLine1 string `json:"address_line1,omitempty"`
+	// AddressLine2 is the second line of the payer’s address.
+	AddressLine2 string `json:"address_line2,omitempty"`
+	// AddressLine3 is the third line of the payer’s address.
+	AddressLine3 string `json:"address_line3,omitempty"`
+	// City is the city of the payer’s address.
+	City string `json:"city,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code.
+	CountryCode string `json:"country_code,omitempty"`
+	// DanishIdentityNumber is the payer’s Danish CPR or CVR number, for Danish payers only.
+	DanishIdentityNumber string `json:"danish_identity_number,omitempty"`
+	// PostalCode is the payer’s postal code.
+	PostalCode string `json:"postal_code,omitempty"`
+	// Region is the payer’s address region, county or department.
+	Region string `json:"region,omitempty"`
+	// SwedishIdentityNumber is the payer’s civic or company number, for Swedish payers only.
+	SwedishIdentityNumber string `json:"swedish_identity_number,omitempty"`
+}
+
+// BillingRequestLinks holds the IDs of the resources linked to a BillingRequest
+type BillingRequestLinks struct {
+	// BankAuthorisation is the ID of the bank authorisation used to complete Instant Bank Pay.
+	BankAuthorisation string `json:"bank_authorisation,omitempty"`
+	// Creditor is the ID of the creditor the billing request is for.
+	Creditor string `json:"creditor,omitempty"`
+	// Customer is the ID of the customer the billing request is for. Set on creation to use an existing customer.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccount is the ID of the payer’s bank account. Set on creation to use an existing bank account.
+	CustomerBankAccount string `json:"customer_bank_account,omitempty"`
+	// CustomerBillingDetail is the ID of the payer’s billing details.
+	CustomerBillingDetail string `json:"customer_billing_detail,omitempty"`
+	// MandateRequest is the ID of the mandate request.
+	MandateRequest string `json:"mandate_request,omitempty"`
+	// MandateRequestMandate is the ID of the mandate created when the billing request was fulfilled.
+	MandateRequestMandate string `json:"mandate_request_mandate,omitempty"`
+	// PaymentRequest is the ID of the payment request.
+	PaymentRequest string `json:"payment_request,omitempty"`
+	// PaymentRequestPayment is the ID of the payment created when the billing request was fulfilled.
+	PaymentRequestPayment string `json:"payment_request_payment,omitempty"`
+}
+
+// BillingRequestListOptions holds the parameters used to filter the results of ListBillingRequests
+type BillingRequestListOptions struct {
+	ListOptions
+	// Customer restricts the results to billing requests for this customer ID
+	Customer string `url:"customer"`
+	// Status restricts the results to billing requests in this state
+	Status BillingRequestStatus `url:"status"`
+}
+
+// CollectCustomerDetailsRequest holds the payer details sent to the collect_customer_details action of a
+// BillingRequest
+type CollectCustomerDetailsRequest struct {
+	// Customer holds the payer’s name and contact details.
+	Customer *BillingRequestCustomer `json:"customer,omitempty"`
+	// CustomerBillingDetail holds the payer’s billing address.
+	CustomerBillingDetail *CustomerBillingDetail `json:"customer_billing_detail,omitempty"`
+}
+
+// BillingRequestCustomer holds the name and contact details of a payer, sent to the collect_customer_details action
+type BillingRequestCustomer struct {
+	// CompanyName is the payer’s company name. Required unless a GivenName and FamilyName are provided.
+	CompanyName string `json:"company_name,omitempty"`
+	// Email is the payer’s email address.
+	Email string `json:"email,omitempty"`
+	// FamilyName is the payer’s surname. Required unless a CompanyName is provided.
+	FamilyName string `json:"family_name,omitempty"`
+	// GivenName is the payer’s first name. Required unless a CompanyName is provided.
+	GivenName string `json:"given_name,omitempty"`
+	// Language is the ISO 639-1 code of the language used for notifications sent to the payer.
+	Language string `json:"language,omitempty"`
+	// Metadata is a key-value store of custom data, copied to the customer.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// PhoneNumber is the payer’s phone number, in E.164 format.
+	PhoneNumber string `json:"phone_number,omitempty"`
+}
+
+// CollectBankAccountRequest holds the bank details sent to the collect_bank_account action of a BillingRequest. Bank
+// details may be supplied either as an IBAN, or as local details using the AccountNumber, BranchCode and BankCode
+// fields
+type CollectBankAccountRequest struct {
+	// AccountHolderName is the name of the account holder, as known by the bank.
+	AccountHolderName string `json:"account_holder_name,omitempty"`
+	// AccountNumber is the bank account number.
+	AccountNumber string `json:"account_number,omitempty"`
+	// AccountNumberSuffix is the account number suffix, for New Zealand accounts only.
+	AccountNumberSuffix string `json:"account_number_suffix,omitempty"`
+	// AccountType is the type of account, either “savings” or “checking”. Only required for USD accounts.
+	AccountType string `json:"account_type,omitempty"`
+	// BankCode is the bank code.
+	BankCode string `json:"bank_code,omitempty"`
+	// BranchCode is the branch code, or sort code in the UK.
+	BranchCode string `json:"branch_code,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code.
+	CountryCode string `json:"country_code,omitempty"`
+	// Currency is the ISO 4217 currency code of the account.
+	Currency string `json:"currency,omitempty"`
+	// IBAN is the international bank account number.
+	IBAN string `json:"iban,omitempty"`
+	// Metadata is a key-value store of custom data, copied to the customer bank account.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ConfirmPayerDetailsRequest holds the data sent to the confirm_payer_details action of a BillingRequest
+type ConfirmPayerDetailsRequest struct {
+	// Metadata is a key-value store of custom data.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// PayerRequestedDualSignature is true when the payer has indicated that more than one signature is required on
+	// their bank account.
+	PayerRequestedDualSignature bool `json:"payer_requested_dual_signature,omitempty"`
+}
+
+// FulfilRequest holds the data sent to the fulfil action of a BillingRequest
+type FulfilRequest struct {
+	// Metadata is a key-value store of custom data.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// NotifyRequest holds the data sent to the notify action of a BillingRequest, which asks GoCardless to contact the
+// payer so that they can complete the request
+type NotifyRequest struct {
+	// NotificationType is the channel used to notify the payer. Currently only “email” is supported.
+	NotificationType string `json:"notification_type"`
+	// RedirectURI is the URL the payer is sent to once they have completed the request.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+}
+
+// ChooseCurrencyRequest holds the data sent to the choose_currency action of a BillingRequest
+type ChooseCurrencyRequest struct {
+	// Currency is the ISO 4217 currency code chosen by the payer, which must be one of the AvailableCurrencies of the
+	// action.
+	Currency string `json:"currency"`
+	// Metadata is a key-value store of custom data.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// SelectInstitutionRequest holds the data sent to the select_institution action of a BillingRequest
+type SelectInstitutionRequest struct {
+	// CountryCode is the ISO 3166-1 alpha-2 code of the institution.
+	CountryCode string `json:"country_code"`
+	// Institution is the unique identifier of the payer’s bank.
+	Institution string `json:"institution"`
+}
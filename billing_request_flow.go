@@ -0,0 +1,82 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// BillingRequestFlow is used to send a payer to the pages hosted by GoCardless, where they can complete the actions
+// of a BillingRequest
+type BillingRequestFlow struct {
+	// ID is a unique identifier, beginning with “BRF”.
+	ID string `json:"id,omitempty"`
+	// AuthorisationURL is the URL of the hosted pages to send the payer to, as returned by the API.
+	AuthorisationURL string `json:"authorisation_url,omitempty"`
+	// AutoFulfil fulfils the billing request automatically once the payer has completed the flow when true.
+	AutoFulfil bool `json:"auto_fulfil,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the flow was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// ExitURI is the URL the payer is sent to if they choose to leave the flow without completing it.
+	ExitURI string `json:"exit_uri,omitempty"`
+	// ExpiresAt is the time after which the flow can no longer be used, as returned by the API.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// LockBankAccount prevents the payer from changing the prefilled bank account when true.
+	LockBankAccount bool `json:"lock_bank_account,omitempty"`
+	// LockCurrency prevents the payer from changing the currency when true.
+	LockCurrency bool `json:"lock_currency,omitempty"`
+	// LockCustomerDetails prevents the payer from changing the prefilled customer details when true.
+	LockCustomerDetails bool `json:"lock_customer_details,omitempty"`
+	// PrefilledBankAccount holds bank account details used to prefill the flow.
+	PrefilledBankAccount *BillingRequestFlowPrefilledBankAccount `json:"prefilled_bank_account,omitempty"`
+	// PrefilledCustomer holds customer details used to prefill the flow.
+	PrefilledCustomer *BillingRequestFlowPrefilledCustomer `json:"prefilled_customer,omitempty"`
+	// RedirectURI is the URL the payer is sent to once they have completed the flow.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// SessionToken is the payer’s session ID in your application.
+	SessionToken string `json:"session_token,omitempty"`
+	// ShowRedirectButtons shows buttons returning the payer to RedirectURI and ExitURI when true.
+	ShowRedirectButtons bool `json:"show_redirect_buttons,omitempty"`
+	// Links holds the IDs of the resources the flow is associated with
+	Links *BillingRequestFlowLinks `json:"links,omitempty"`
+}
+
+// BillingRequestFlowPrefilledCustomer holds the customer details used to prefill a BillingRequestFlow
+type BillingRequestFlowPrefilledCustomer struct {
+	// AddressLine1 is the first line of the payer’s address.
+	AddressLine1 string `json:"address_line1,omitempty"`
+	// AddressLine2 is the second line of the payer’s address.
+	AddressLine2 string `json:"address_line2,omitempty"`
+	// AddressLine3 is the third line of the payer’s address.
+	AddressLine3 string `json:"address_line3,omitempty"`
+	// City is the city of the payer’s address.
+	City string `json:"city,omitempty"`
+	// CompanyName is the payer’s company name.
+	CompanyName string `json:"company_name,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code.
+	CountryCode string `json:"country_code,omitempty"`
+	// DanishIdentityNumber is the payer’s Danish CPR or CVR number, for Danish payers only.
+	DanishIdentityNumber string `json:"danish_identity_number,omitempty"`
+	// Email is the payer’s email address.
+	Email string `json:"email,omitempty"`
+	// FamilyName is the payer’s surname.
+	FamilyName string `json:"family_name,omitempty"`
+	// GivenName is the payer’s first name.
+	GivenName string `json:"given_name,omitempty"`
+	// PostalCode is the payer’s postal code.
+	PostalCode string `json:"postal_code,omitempty"`
+	// Region is the payer’s address region, county or department.
+	Region string `json:"region,omitempty"`
+	// SwedishIdentityNumber is the payer’s civic or company number, for Swedish payers only.
+	SwedishIdentityNumber string `json:"swedish_identity_number,omitempty"`
+}
+
+// BillingRequestFlowPrefilledBankAccount holds the bank account details used to prefill a BillingRequestFlow
+type BillingRequestFlowPrefilledBankAccount struct {
+	// AccountType is the type of account, either “savings” or “checking”. Only used for USD accounts.
+	AccountType string `json:"account_type,omitempty"`
+}
+
+// BillingRequestFlowLinks holds the IDs of the resources linked to a BillingRequestFlow
+type BillingRequestFlowLinks struct {
+	// BillingRequest is the ID of the billing request the payer completes using the flow.
+	BillingRequest string `json:"billing_request,omitempty"`
+}
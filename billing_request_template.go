@@ -0,0 +1,51 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// BillingRequestTemplate is a reusable payment link. Each time a payer visits its AuthorisationURL a new
+// BillingRequest is created from the template
+type BillingRequestTemplate struct {
+	// ID is a unique identifier, beginning with “BRT”.
+	ID string `json:"id,omitempty"`
+	// AuthorisationURL is the permanent URL the payer visits to complete a billing request, as returned by the API.
+	AuthorisationURL string `json:"authorisation_url,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the template was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// MandateRequestCurrency is the ISO 4217 currency code of the mandate to be created.
+	MandateRequestCurrency string `json:"mandate_request_currency,omitempty"`
+	// MandateRequestDescription is a human-readable description of the mandate, shown to the payer.
+	MandateRequestDescription string `json:"mandate_request_description,omitempty"`
+	// MandateRequestMetadata is a key-value store of custom data, copied to each mandate created.
+	MandateRequestMetadata map[string]string `json:"mandate_request_metadata,omitempty"`
+	// MandateRequestScheme is the Direct Debit scheme of the mandate.
+	MandateRequestScheme string `json:"mandate_request_scheme,omitempty"`
+	// MandateRequestVerify controls whether the payer’s bank account is verified.
+	MandateRequestVerify string `json:"mandate_request_verify,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Name is the name of the template.
+	Name string `json:"name,omitempty"`
+	// PaymentRequestAmount is the amount of the payment to be collected, in minor unit as a string.
+	PaymentRequestAmount string `json:"payment_request_amount,omitempty"`
+	// PaymentRequestCurrency is the ISO 4217 currency code of the payment to be collected.
+	PaymentRequestCurrency string `json:"payment_request_currency,omitempty"`
+	// PaymentRequestDescription is a human-readable description of the payment, shown to the payer.
+	PaymentRequestDescription string `json:"payment_request_description,omitempty"`
+	// PaymentRequestMetadata is a key-value store of custom data, copied to each payment created.
+	PaymentRequestMetadata map[string]string `json:"payment_request_metadata,omitempty"`
+	// PaymentRequestScheme is the scheme used to collect the payment, such as “faster_payments”.
+	PaymentRequestScheme string `json:"payment_request_scheme,omitempty"`
+	// RedirectURI is the URL the payer is sent to once they have completed the billing request.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// UpdatedAt is a timestamp recording when the template was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// BillingRequestTemplateListOptions holds the parameters used to page through the results of
+// ListBillingRequestTemplates
+type BillingRequestTemplateListOptions struct {
+	ListOptions
+}
@@ -2,12 +2,14 @@ package gocardless
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const (
@@ -22,9 +24,194 @@ const (
 // NewClient. A mock type called MockClient is also provided
 type API interface {
 	CreateCustomer(*Customer) error
+	CreateCustomerWithContext(context.Context, *Customer) error
 	GetCustomer(string) (*Customer, error)
+	GetCustomerWithContext(context.Context, string) (*Customer, error)
 	ListCustomer() ([]*Customer, error)
+	ListCustomerWithContext(context.Context) ([]*Customer, error)
 	UpdateCustomer(*Customer) error
+	UpdateCustomerWithContext(context.Context, *Customer) error
+	RemoveCustomer(string) (*Customer, error)
+	RemoveCustomerWithContext(context.Context, string) (*Customer, error)
+
+	CreateCustomerBankAccount(*CustomerBankAccount) error
+	CreateCustomerBankAccountWithContext(context.Context, *CustomerBankAccount) error
+	GetCustomerBankAccount(string) (*CustomerBankAccount, error)
+	GetCustomerBankAccountWithContext(context.Context, string) (*CustomerBankAccount, error)
+	ListCustomerBankAccounts(*CustomerBankAccountListOptions) ([]*CustomerBankAccount, error)
+	ListCustomerBankAccountsWithContext(context.Context, *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error)
+	UpdateCustomerBankAccount(*CustomerBankAccount) error
+	UpdateCustomerBankAccountWithContext(context.Context, *CustomerBankAccount) error
+	DisableCustomerBankAccount(string) (*CustomerBankAccount, error)
+	DisableCustomerBankAccountWithContext(context.Context, string) (*CustomerBankAccount, error)
+
+	CreateMandate(*Mandate) error
+	CreateMandateWithContext(context.Context, *Mandate) error
+	GetMandate(string) (*Mandate, error)
+	GetMandateWithContext(context.Context, string) (*Mandate, error)
+	ListMandates(*MandateListOptions) ([]*Mandate, error)
+	ListMandatesWithContext(context.Context, *MandateListOptions) ([]*Mandate, error)
+	UpdateMandate(*Mandate) error
+	UpdateMandateWithContext(context.Context, *Mandate) error
+	CancelMandate(string) (*Mandate, error)
+	CancelMandateWithContext(context.Context, string) (*Mandate, error)
+	ReinstateMandate(string) (*Mandate, error)
+	ReinstateMandateWithContext(context.Context, string) (*Mandate, error)
+
+	CreatePayment(*Payment) error
+	CreatePaymentWithContext(context.Context, *Payment) error
+	GetPayment(string) (*Payment, error)
+	GetPaymentWithContext(context.Context, string) (*Payment, error)
+	ListPayments(*PaymentListOptions) ([]*Payment, error)
+	ListPaymentsWithContext(context.Context, *PaymentListOptions) ([]*Payment, error)
+	UpdatePayment(*Payment) error
+	UpdatePaymentWithContext(context.Context, *Payment) error
+	CancelPayment(string) (*Payment, error)
+	CancelPaymentWithContext(context.Context, string) (*Payment, error)
+	RetryPayment(string) (*Payment, error)
+	RetryPaymentWithContext(context.Context, string) (*Payment, error)
+
+	CreateSubscription(*Subscription) error
+	CreateSubscriptionWithContext(context.Context, *Subscription) error
+	GetSubscription(string) (*Subscription, error)
+	GetSubscriptionWithContext(context.Context, string) (*Subscription, error)
+	ListSubscriptions(*SubscriptionListOptions) ([]*Subscription, error)
+	ListSubscriptionsWithContext(context.Context, *SubscriptionListOptions) ([]*Subscription, error)
+	UpdateSubscription(*Subscription) error
+	UpdateSubscriptionWithContext(context.Context, *Subscription) error
+	PauseSubscription(string) (*Subscription, error)
+	PauseSubscriptionWithContext(context.Context, string) (*Subscription, error)
+	ResumeSubscription(string) (*Subscription, error)
+	ResumeSubscriptionWithContext(context.Context, string) (*Subscription, error)
+	CancelSubscription(string) (*Subscription, error)
+	CancelSubscriptionWithContext(context.Context, string) (*Subscription, error)
+
+	CreateRefund(*Refund) error
+	CreateRefundWithContext(context.Context, *Refund) error
+	GetRefund(string) (*Refund, error)
+	GetRefundWithContext(context.Context, string) (*Refund, error)
+	ListRefunds(*RefundListOptions) ([]*Refund, error)
+	ListRefundsWithContext(context.Context, *RefundListOptions) ([]*Refund, error)
+	UpdateRefund(*Refund) error
+	UpdateRefundWithContext(context.Context, *Refund) error
+
+	GetPayout(string) (*Payout, error)
+	GetPayoutWithContext(context.Context, string) (*Payout, error)
+	ListPayouts(*PayoutListOptions) ([]*Payout, error)
+	ListPayoutsWithContext(context.Context, *PayoutListOptions) ([]*Payout, error)
+	ListPayoutItems(*PayoutItemListOptions) ([]*PayoutItem, error)
+	ListPayoutItemsWithContext(context.Context, *PayoutItemListOptions) ([]*PayoutItem, error)
+
+	CreateCreditor(*Creditor) error
+	CreateCreditorWithContext(context.Context, *Creditor) error
+	GetCreditor(string) (*Creditor, error)
+	GetCreditorWithContext(context.Context, string) (*Creditor, error)
+	ListCreditors(*CreditorListOptions) ([]*Creditor, error)
+	ListCreditorsWithContext(context.Context, *CreditorListOptions) ([]*Creditor, error)
+	UpdateCreditor(*Creditor) error
+	UpdateCreditorWithContext(context.Context, *Creditor) error
+
+	CreateCreditorBankAccount(*CreditorBankAccount) error
+	CreateCreditorBankAccountWithContext(context.Context, *CreditorBankAccount) error
+	GetCreditorBankAccount(string) (*CreditorBankAccount, error)
+	GetCreditorBankAccountWithContext(context.Context, string) (*CreditorBankAccount, error)
+	ListCreditorBankAccounts(*CreditorBankAccountListOptions) ([]*CreditorBankAccount, error)
+	ListCreditorBankAccountsWithContext(context.Context, *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error)
+	DisableCreditorBankAccount(string) (*CreditorBankAccount, error)
+	DisableCreditorBankAccountWithContext(context.Context, string) (*CreditorBankAccount, error)
+
+	GetEvent(string) (*Event, error)
+	GetEventWithContext(context.Context, string) (*Event, error)
+	ListEvents(*EventListOptions) ([]*Event, *Linked, error)
+	ListEventsWithContext(context.Context, *EventListOptions) ([]*Event, *Linked, error)
+
+	CreateRedirectFlow(*RedirectFlow) error
+	CreateRedirectFlowWithContext(context.Context, *RedirectFlow) error
+	GetRedirectFlow(string) (*RedirectFlow, error)
+	GetRedirectFlowWithContext(context.Context, string) (*RedirectFlow, error)
+	CompleteRedirectFlow(string, string) (*RedirectFlow, error)
+	CompleteRedirectFlowWithContext(context.Context, string, string) (*RedirectFlow, error)
+
+	CreateBillingRequest(*BillingRequest) error
+	CreateBillingRequestWithContext(context.Context, *BillingRequest) error
+	GetBillingRequest(string) (*BillingRequest, error)
+	GetBillingRequestWithContext(context.Context, string) (*BillingRequest, error)
+	ListBillingRequests(*BillingRequestListOptions) ([]*BillingRequest, error)
+	ListBillingRequestsWithContext(context.Context, *BillingRequestListOptions) ([]*BillingRequest, error)
+	CancelBillingRequest(string) (*BillingRequest, error)
+	CancelBillingRequestWithContext(context.Context, string) (*BillingRequest, error)
+	CollectBillingRequestCustomerDetails(string, *CollectCustomerDetailsRequest) (*BillingRequest, error)
+	CollectBillingRequestCustomerDetailsWithContext(context.Context, string, *CollectCustomerDetailsRequest) (*BillingRequest, error)
+	CollectBillingRequestBankAccount(string, *CollectBankAccountRequest) (*BillingRequest, error)
+	CollectBillingRequestBankAccountWithContext(context.Context, string, *CollectBankAccountRequest) (*BillingRequest, error)
+	ConfirmBillingRequestPayerDetails(string, *ConfirmPayerDetailsRequest) (*BillingRequest, error)
+	ConfirmBillingRequestPayerDetailsWithContext(context.Context, string, *ConfirmPayerDetailsRequest) (*BillingRequest, error)
+	FulfilBillingRequest(string, *FulfilRequest) (*BillingRequest, error)
+	FulfilBillingRequestWithContext(context.Context, string, *FulfilRequest) (*BillingRequest, error)
+	NotifyBillingRequest(string, *NotifyRequest) (*BillingRequest, error)
+	NotifyBillingRequestWithContext(context.Context, string, *NotifyRequest) (*BillingRequest, error)
+	FallbackBillingRequest(string) (*BillingRequest, error)
+	FallbackBillingRequestWithContext(context.Context, string) (*BillingRequest, error)
+	ChooseBillingRequestCurrency(string, *ChooseCurrencyRequest) (*BillingRequest, error)
+	ChooseBillingRequestCurrencyWithContext(context.Context, string, *ChooseCurrencyRequest) (*BillingRequest, error)
+	SelectBillingRequestInstitution(string, *SelectInstitutionRequest) (*BillingRequest, error)
+	SelectBillingRequestInstitutionWithContext(context.Context, string, *SelectInstitutionRequest) (*BillingRequest, error)
+
+	CreateBillingRequestFlow(*BillingRequestFlow) error
+	CreateBillingRequestFlowWithContext(context.Context, *BillingRequestFlow) error
+	InitialiseBillingRequestFlow(string) (*BillingRequestFlow, error)
+	InitialiseBillingRequestFlowWithContext(context.Context, string) (*BillingRequestFlow, error)
+
+	CreateBillingRequestTemplate(*BillingRequestTemplate) error
+	CreateBillingRequestTemplateWithContext(context.Context, *BillingRequestTemplate) error
+	GetBillingRequestTemplate(string) (*BillingRequestTemplate, error)
+	GetBillingRequestTemplateWithContext(context.Context, string) (*BillingRequestTemplate, error)
+	ListBillingRequestTemplates(*BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error)
+	ListBillingRequestTemplatesWithContext(context.Context, *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error)
+	UpdateBillingRequestTemplate(*BillingRequestTemplate) error
+	UpdateBillingRequestTemplateWithContext(context.Context, *BillingRequestTemplate) error
+
+	CreateInstalmentScheduleWithDates(*InstalmentSchedule, []*Instalment) error
+	CreateInstalmentScheduleWithDatesWithContext(context.Context, *InstalmentSchedule, []*Instalment) error
+	CreateInstalmentScheduleWithSchedule(*InstalmentSchedule, *InstalmentPlan) error
+	CreateInstalmentScheduleWithScheduleWithContext(context.Context, *InstalmentSchedule, *InstalmentPlan) error
+	GetInstalmentSchedule(string) (*InstalmentSchedule, error)
+	GetInstalmentScheduleWithContext(context.Context, string) (*InstalmentSchedule, error)
+	ListInstalmentSchedules(*InstalmentScheduleListOptions) ([]*InstalmentSchedule, error)
+	ListInstalmentSchedulesWithContext(context.Context, *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error)
+	UpdateInstalmentSchedule(*InstalmentSchedule) error
+	UpdateInstalmentScheduleWithContext(context.Context, *InstalmentSchedule) error
+	CancelInstalmentSchedule(string) (*InstalmentSchedule, error)
+	CancelInstalmentScheduleWithContext(context.Context, string) (*InstalmentSchedule, error)
+
+	CreateMandateImport(*MandateImport) error
+	CreateMandateImportWithContext(context.Context, *MandateImport) error
+	GetMandateImport(string) (*MandateImport, error)
+	GetMandateImportWithContext(context.Context, string) (*MandateImport, error)
+	SubmitMandateImport(string) (*MandateImport, error)
+	SubmitMandateImportWithContext(context.Context, string) (*MandateImport, error)
+	CancelMandateImport(string) (*MandateImport, error)
+	CancelMandateImportWithContext(context.Context, string) (*MandateImport, error)
+	AddMandateImportEntry(*MandateImportEntry) error
+	AddMandateImportEntryWithContext(context.Context, *MandateImportEntry) error
+	ListMandateImportEntries(*MandateImportEntryListOptions) ([]*MandateImportEntry, error)
+	ListMandateImportEntriesWithContext(context.Context, *MandateImportEntryListOptions) ([]*MandateImportEntry, error)
+
+	LookupBankDetails(*BankDetails) (*BankDetailsLookup, error)
+	LookupBankDetailsWithContext(context.Context, *BankDetails) (*BankDetailsLookup, error)
+
+	CreateMandatePDF(*MandatePDFRequest, string) (*MandatePDF, error)
+	CreateMandatePDFWithContext(context.Context, *MandatePDFRequest, string) (*MandatePDF, error)
+}
+
+// metadataUpdate is the request body used to update resources where Metadata is the only field that may be changed
+type metadataUpdate struct {
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// actionRequest is the request body used to send data to the action endpoints of a resource
+type actionRequest struct {
+	Data interface{} `json:"data"`
 }
 
 // Client is an implementation of the GoCardless API interface.
@@ -33,24 +220,62 @@ type Client struct {
 	AccessToken string
 	// RemoteURL is the address of the GoCardless API
 	RemoteURL string
+	// Environment is the GoCardless environment the Client connects to. It is used to refuse requests to endpoints
+	// which are restricted in that environment
+	Environment Environment
+	// RecoverIdempotentConflicts, when true, makes the create methods return the resource created by an earlier
+	// request with the same Idempotency-Key, instead of returning an idempotent_creation_conflict error. A create
+	// which has been retried by the RetryPolicy always recovers the conflict, as it is usually caused by an earlier
+	// attempt reaching GoCardless. Resources which cannot be retrieved by ID, such as mandate import entries, are not
+	// recovered. It can be set by NewClient with WithRecoverIdempotentConflicts. See WithIdempotencyKey
+	RecoverIdempotentConflicts bool
+	// RetryPolicy controls how requests which fail are retried. When nil each request is only attempted once
+	RetryPolicy *RetryPolicy
+	// RateLimiter, when set, tracks the rate limit reported by each response and delays requests when it is nearly
+	// exhausted. It may be shared between Clients using the same access token
+	RateLimiter *RateLimiter
+	// HTTPClient is used to send requests. When nil http.DefaultClient is used
+	HTTPClient *http.Client
+	// APIVersion is the version of the GoCardless API requested. When blank the APIVersion constant is used
+	APIVersion string
+	// UserAgent, when set, is sent as the User-Agent header of each request
+	UserAgent string
+
+	// timeout is the timeout set by WithTimeout, which is also applied to an HTTPClient set after it by WithHTTPClient
+	timeout time.Duration
+}
+
+// isLive reports whether requests are sent to the live API, either because the Client is using the LiveEnvironment or
+// because its RemoteURL is the live API, which may have been set directly or with WithBaseURL
+func (c *Client) isLive() bool {
+	return c.Environment == LiveEnvironment || strings.TrimRight(c.RemoteURL, `/`) == BaseLiveURL
 }
 
 func (c *Client) do(req *http.Request) (*Response, error) {
-	client := &http.Client{}
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 
+	response := &Response{resp}
+	if c.RateLimiter != nil {
+		c.RateLimiter.update(response)
+	}
+
 	switch resp.StatusCode {
 	case http.StatusTooManyRequests:
-		return nil, errors.New("StatusTooManyRequests")
+		resp.Body.Close()
+		return nil, &RateLimitedExceededError{RateReset: response.RateReset()}
 	}
 
-	return &Response{resp}, nil
+	return response, nil
 }
 
-func (c *Client) newRequest(path, method string, data []byte) (*http.Request, error) {
+func (c *Client) newRequest(ctx context.Context, path, method string, data []byte) (*http.Request, error) {
 	if strings.ToUpper(method) == http.MethodPatch {
 		return nil, errors.New(InvalidMethodError)
 	}
@@ -58,18 +283,125 @@ func (c *Client) newRequest(path, method string, data []byte) (*http.Request, er
 	endpoint := fmt.Sprintf("%s%s", c.RemoteURL, path)
 
 	body := ioutil.NopCloser(bytes.NewBuffer(data))
-	req, err := http.NewRequest(method, endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Add(`Authorization`, fmt.Sprintf(`Bearer %s`, c.AccessToken))
-	req.Header.Add(`GoCardless-Version`, APIVersion)
+	version := c.APIVersion
+	if version == `` {
+		version = APIVersion
+	}
+	req.Header.Add(`GoCardless-Version`, version)
 	req.Header.Add(`Accept`, jsonMimeType)
 	req.Header.Add(`Content-Type`, jsonMimeType)
+	if c.UserAgent != `` {
+		req.Header.Add(`User-Agent`, c.UserAgent)
+	}
 
 	return req, nil
 }
 
+// execute sends a request to path using method, which is cancelled when ctx is done. When body is not nil it is
+// marshalled as the JSON request body, and when result is not nil a successful response is unmarshalled into it. Any
+// response outside of the 2xx range is returned as one of the error types wrapping an *Error
+func (c *Client) execute(ctx context.Context, method, path string, body, result interface{}) error {
+	return c.executeWithHeader(ctx, method, path, nil, body, result)
+}
+
+// executeWithHeader behaves as execute, with the values in header replacing any of the same name set by newRequest.
+// Requests which fail are sent again when permitted by the RetryPolicy of the Client
+func (c *Client) executeWithHeader(ctx context.Context, method, path string, header http.Header, body, result interface{}) error {
+	_, err := c.executeAttempts(ctx, method, path, header, body, result)
+	return err
+}
+
+// executeAttempts behaves as executeWithHeader, also returning the number of times the request was sent
+func (c *Client) executeAttempts(ctx context.Context, method, path string, header http.Header, body, result interface{}) (int, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return 0, err
+		}
+	}
+
+	attempts := 1
+	if c.RetryPolicy != nil && isRetryable(method, header) {
+		attempts = c.RetryPolicy.attempts()
+	}
+
+	var statusCode int
+	var respBody []byte
+	var err error
+	attempt := 1
+	for ; ; attempt++ {
+		statusCode, respBody, err = c.send(ctx, method, path, header, data)
+		if attempt >= attempts || !shouldRetry(ctx, statusCode, err) {
+			break
+		}
+		if err := sleep(ctx, c.RetryPolicy.delay(attempt, err)); err != nil {
+			return attempt, err
+		}
+	}
+	if err != nil {
+		return attempt, err
+	}
+
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return attempt, c.responseError(statusCode, respBody)
+	}
+
+	if result == nil || len(respBody) == 0 {
+		return attempt, nil
+	}
+	return attempt, json.Unmarshal(respBody, result)
+}
+
+// send makes a single request, returning the status code and body of the response
+func (c *Client) send(ctx context.Context, method, path string, header http.Header, data []byte) (int, []byte, error) {
+	if c.RateLimiter != nil {
+		if err := c.RateLimiter.wait(ctx); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	req, err := c.newRequest(ctx, path, method, data)
+	if err != nil {
+		return 0, nil, err
+	}
+	for key, values := range header {
+		req.Header.Del(key)
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// responseError decodes the body of an unsuccessful response, returning it as the most specific error type for the
+// error. Where the body is not a GoCardless error message an *Error is built from the status code alone
+func (c *Client) responseError(statusCode int, body []byte) error {
+	if err := c.decodeError(body); err != nil {
+		return typedError(err)
+	}
+	return typedError(&Error{
+		Message: http.StatusText(statusCode),
+		Code:    statusCode,
+	})
+}
+
 func (c *Client) decodeError(error []byte) *Error {
 	newErr := &errorContainer{}
 	if err := json.Unmarshal(error, newErr); err != nil {
@@ -79,10 +411,12 @@ func (c *Client) decodeError(error []byte) *Error {
 }
 
 // NewClient returns a populated API Client which has been configured for the supplied environment, using the Access
-// Token for authenticating all requests. An error will be returned in cases where the environment is not recognised
-func NewClient(accessToken string, environment Environment) (API, error) {
+// Token for authenticating all requests. Any options are applied in order once the environment has been configured.
+// An error will be returned in cases where the environment is not recognised
+func NewClient(accessToken string, environment Environment, options ...ClientOption) (API, error) {
 	c := &Client{
 		AccessToken: accessToken,
+		Environment: environment,
 	}
 
 	switch environment {
@@ -94,5 +428,22 @@ func NewClient(accessToken string, environment Environment) (API, error) {
 		return nil, errors.New(fmt.Sprintf("%s is not a valid environment", environment))
 	}
 
+	for _, option := range options {
+		option(c)
+	}
 	return c, nil
 }
+
+// applyTimeout replaces the HTTPClient with a copy which has the timeout set by WithTimeout, so that an http.Client
+// shared with other code is not modified
+func (c *Client) applyTimeout() {
+	if c.timeout <= 0 {
+		return
+	}
+	httpClient := &http.Client{}
+	if c.HTTPClient != nil {
+		*httpClient = *c.HTTPClient
+	}
+	httpClient.Timeout = c.timeout
+	c.HTTPClient = httpClient
+}
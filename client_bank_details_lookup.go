@@ -0,0 +1,41 @@
+package gocardless
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	bankDetailsLookupEndpoint = `/bank_details_lookups`
+)
+
+// bankDetailsLookupRequest is a utility struct used to wrap the JSON request being passed to the remote API
+type bankDetailsLookupRequest struct {
+	BankDetails *BankDetails `json:"bank_details_lookups"`
+}
+
+// bankDetailsLookupWrapper is a utility struct used to unwrap the JSON response of the remote API
+type bankDetailsLookupWrapper struct {
+	BankDetailsLookup *BankDetailsLookup `json:"bank_details_lookups"`
+}
+
+// LookupBankDetails returns the name of the bank, its BIC and the Direct Debit schemes available for the bank
+// details. The details are checked with ValidateBankDetails first, and any problems are returned without contacting
+// the API
+func (c *Client) LookupBankDetails(details *BankDetails) (*BankDetailsLookup, error) {
+	return c.LookupBankDetailsWithContext(context.Background(), details)
+}
+
+// LookupBankDetailsWithContext is LookupBankDetails with a context, which can cancel the request or set its deadline
+func (c *Client) LookupBankDetailsWithContext(ctx context.Context, details *BankDetails) (*BankDetailsLookup, error) {
+	if err := ValidateBankDetails(details); err != nil {
+		return nil, err
+	}
+
+	wrapper := &bankDetailsLookupWrapper{}
+	request := &bankDetailsLookupRequest{details}
+	if err := c.execute(ctx, http.MethodPost, bankDetailsLookupEndpoint, request, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BankDetailsLookup, nil
+}
@@ -0,0 +1,85 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestClientLookupBankDetails(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody []byte
+			requests := 0
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				requestBody, _ = ioutil.ReadAll(req.Body)
+				w.Write([]byte(`{
+					"bank_details_lookups": {
+						"available_debit_schemes": ["bacs"],
+						"bank_name": "BARCLAYS BANK PLC",
+						"bic": "BARCGB22XXX"
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I look up valid bank details`, func() {
+				lookup, err := client.LookupBankDetails(&BankDetails{
+					AccountNumber: `55779911`,
+					BranchCode:    `200000`,
+					CountryCode:   `GB`,
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the request will be a POST to the bank details lookups endpoint`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+					So(requestPath, ShouldEqual, bankDetailsLookupEndpoint)
+				})
+
+				Convey(`Then the details will be wrapped in the request body`, func() {
+					body := map[string]map[string]string{}
+					So(json.Unmarshal(requestBody, &body), ShouldBeNil)
+					So(body[`bank_details_lookups`][`account_number`], ShouldEqual, `55779911`)
+					So(body[`bank_details_lookups`][`branch_code`], ShouldEqual, `200000`)
+					So(body[`bank_details_lookups`][`country_code`], ShouldEqual, `GB`)
+				})
+
+				Convey(`Then the lookup will be populated from the response`, func() {
+					So(lookup.AvailableDebitSchemes, ShouldResemble, []string{`bacs`})
+					So(lookup.BankName, ShouldEqual, `BARCLAYS BANK PLC`)
+					So(lookup.BIC, ShouldEqual, `BARCGB22XXX`)
+				})
+			})
+
+			Convey(`When I look up bank details which fail local validation`, func() {
+				lookup, err := client.LookupBankDetails(&BankDetails{IBAN: `GB61BARC20000055779911`})
+
+				Convey(`Then a validation error will be returned`, func() {
+					So(lookup, ShouldBeNil)
+					So(err, ShouldNotBeNil)
+					So(err.(ValidationError).Err.Type, ShouldEqual, ValidationFailedType)
+				})
+
+				Convey(`Then the API will not be called`, func() {
+					So(requests, ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
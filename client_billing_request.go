@@ -0,0 +1,185 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+const (
+	billingRequestEndpoint = `/billing_requests`
+)
+
+// billingRequestWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type billingRequestWrapper struct {
+	BillingRequest *BillingRequest `json:"billing_requests"`
+}
+
+// billingRequestListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type billingRequestListWrapper struct {
+	BillingRequests []*BillingRequest `json:"billing_requests"`
+}
+
+// CreateBillingRequest creates the billing request with the remote API. At least one of the MandateRequest and
+// PaymentRequest fields must be set. On success the billing request is updated with the values returned by the API
+func (c *Client) CreateBillingRequest(billingRequest *BillingRequest) error {
+	return c.CreateBillingRequestWithContext(context.Background(), billingRequest)
+}
+
+// CreateBillingRequestWithContext is CreateBillingRequest with a context, which can cancel the request or set its
+// deadline
+func (c *Client) CreateBillingRequestWithContext(ctx context.Context, billingRequest *BillingRequest) error {
+	wrapper := &billingRequestWrapper{billingRequest}
+	return c.create(ctx, billingRequestEndpoint, wrapper, wrapper)
+}
+
+// GetBillingRequest retrieves the details of the billing request with the given ID
+func (c *Client) GetBillingRequest(id string) (*BillingRequest, error) {
+	return c.GetBillingRequestWithContext(context.Background(), id)
+}
+
+// GetBillingRequestWithContext is GetBillingRequest with a context, which can cancel the request or set its deadline
+func (c *Client) GetBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	wrapper := &billingRequestWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, billingRequestEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequest, nil
+}
+
+// ListBillingRequests returns the billing requests matching the supplied options. The options may be nil
+func (c *Client) ListBillingRequests(options *BillingRequestListOptions) ([]*BillingRequest, error) {
+	return c.ListBillingRequestsWithContext(context.Background(), options)
+}
+
+// ListBillingRequestsWithContext is ListBillingRequests with a context, which can cancel the request or set its
+// deadline
+func (c *Client) ListBillingRequestsWithContext(ctx context.Context, options *BillingRequestListOptions) ([]*BillingRequest, error) {
+	wrapper := &billingRequestListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(billingRequestEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequests, nil
+}
+
+// CancelBillingRequest immediately cancels the billing request with the given ID, after which it can no longer be
+// used
+func (c *Client) CancelBillingRequest(id string) (*BillingRequest, error) {
+	return c.CancelBillingRequestWithContext(context.Background(), id)
+}
+
+// CancelBillingRequestWithContext is CancelBillingRequest with a context, which can cancel the request or set its
+// deadline
+func (c *Client) CancelBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `cancel`, nil)
+}
+
+// CollectBillingRequestCustomerDetails completes the collect_customer_details action of the billing request with the
+// given ID
+func (c *Client) CollectBillingRequestCustomerDetails(id string, details *CollectCustomerDetailsRequest) (*BillingRequest, error) {
+	return c.CollectBillingRequestCustomerDetailsWithContext(context.Background(), id, details)
+}
+
+// CollectBillingRequestCustomerDetailsWithContext is CollectBillingRequestCustomerDetails with a context, which can
+// cancel the request or set its deadline
+func (c *Client) CollectBillingRequestCustomerDetailsWithContext(ctx context.Context, id string, details *CollectCustomerDetailsRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `collect_customer_details`, details)
+}
+
+// CollectBillingRequestBankAccount completes the collect_bank_account action of the billing request with the given ID
+func (c *Client) CollectBillingRequestBankAccount(id string, account *CollectBankAccountRequest) (*BillingRequest, error) {
+	return c.CollectBillingRequestBankAccountWithContext(context.Background(), id, account)
+}
+
+// CollectBillingRequestBankAccountWithContext is CollectBillingRequestBankAccount with a context, which can cancel the
+// request or set its deadline
+func (c *Client) CollectBillingRequestBankAccountWithContext(ctx context.Context, id string, account *CollectBankAccountRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `collect_bank_account`, account)
+}
+
+// ConfirmBillingRequestPayerDetails completes the confirm_payer_details action of the billing request with the given
+// ID, once the payer has checked the details collected from them
+func (c *Client) ConfirmBillingRequestPayerDetails(id string, confirmation *ConfirmPayerDetailsRequest) (*BillingRequest, error) {
+	return c.ConfirmBillingRequestPayerDetailsWithContext(context.Background(), id, confirmation)
+}
+
+// ConfirmBillingRequestPayerDetailsWithContext is ConfirmBillingRequestPayerDetails with a context, which can cancel
+// the request or set its deadline
+func (c *Client) ConfirmBillingRequestPayerDetailsWithContext(ctx context.Context, id string, confirmation *ConfirmPayerDetailsRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `confirm_payer_details`, confirmation)
+}
+
+// FulfilBillingRequest fulfils the billing request with the given ID, creating the requested mandate and payment. All
+// required actions must have been completed
+func (c *Client) FulfilBillingRequest(id string, fulfilment *FulfilRequest) (*BillingRequest, error) {
+	return c.FulfilBillingRequestWithContext(context.Background(), id, fulfilment)
+}
+
+// FulfilBillingRequestWithContext is FulfilBillingRequest with a context, which can cancel the request or set its
+// deadline
+func (c *Client) FulfilBillingRequestWithContext(ctx context.Context, id string, fulfilment *FulfilRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `fulfil`, fulfilment)
+}
+
+// NotifyBillingRequest asks GoCardless to notify the payer of the billing request with the given ID, so that they can
+// complete it themselves
+func (c *Client) NotifyBillingRequest(id string, notification *NotifyRequest) (*BillingRequest, error) {
+	return c.NotifyBillingRequestWithContext(context.Background(), id, notification)
+}
+
+// NotifyBillingRequestWithContext is NotifyBillingRequest with a context, which can cancel the request or set its
+// deadline
+func (c *Client) NotifyBillingRequestWithContext(ctx context.Context, id string, notification *NotifyRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `notify`, notification)
+}
+
+// FallbackBillingRequest falls back from Instant Bank Pay to a Direct Debit mandate for the billing request with the
+// given ID. The action takes no data, and FallbackEnabled must have been set when the request was created
+func (c *Client) FallbackBillingRequest(id string) (*BillingRequest, error) {
+	return c.FallbackBillingRequestWithContext(context.Background(), id)
+}
+
+// FallbackBillingRequestWithContext is FallbackBillingRequest with a context, which can cancel the request or set its
+// deadline
+func (c *Client) FallbackBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `fallback`, nil)
+}
+
+// ChooseBillingRequestCurrency completes the choose_currency action of the billing request with the given ID
+func (c *Client) ChooseBillingRequestCurrency(id string, choice *ChooseCurrencyRequest) (*BillingRequest, error) {
+	return c.ChooseBillingRequestCurrencyWithContext(context.Background(), id, choice)
+}
+
+// ChooseBillingRequestCurrencyWithContext is ChooseBillingRequestCurrency with a context, which can cancel the request
+// or set its deadline
+func (c *Client) ChooseBillingRequestCurrencyWithContext(ctx context.Context, id string, choice *ChooseCurrencyRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `choose_currency`, choice)
+}
+
+// SelectBillingRequestInstitution completes the select_institution action of the billing request with the given ID
+func (c *Client) SelectBillingRequestInstitution(id string, selection *SelectInstitutionRequest) (*BillingRequest, error) {
+	return c.SelectBillingRequestInstitutionWithContext(context.Background(), id, selection)
+}
+
+// SelectBillingRequestInstitutionWithContext is SelectBillingRequestInstitution with a context, which can cancel the
+// request or set its deadline
+func (c *Client) SelectBillingRequestInstitutionWithContext(ctx context.Context, id string, selection *SelectInstitutionRequest) (*BillingRequest, error) {
+	return c.billingRequestAction(ctx, id, `select_institution`, selection)
+}
+
+// billingRequestAction posts data to the named action of the billing request. The request body is omitted when data
+// is nil
+func (c *Client) billingRequestAction(ctx context.Context, id, action string, data interface{}) (*BillingRequest, error) {
+	var request interface{}
+	if value := reflect.ValueOf(data); value.IsValid() && !value.IsNil() {
+		request = &actionRequest{data}
+	}
+
+	wrapper := &billingRequestWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/%s`, billingRequestEndpoint, id, action)
+	if err := c.execute(ctx, http.MethodPost, path, request, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequest, nil
+}
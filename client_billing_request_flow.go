@@ -0,0 +1,48 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	billingRequestFlowEndpoint = `/billing_request_flows`
+)
+
+// billingRequestFlowWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote
+// API
+type billingRequestFlowWrapper struct {
+	BillingRequestFlow *BillingRequestFlow `json:"billing_request_flows"`
+}
+
+// CreateBillingRequestFlow creates the flow with the remote API. The Links.BillingRequest field must be set. On
+// success the flow is updated with the values returned by the API, and the payer should be sent to its
+// AuthorisationURL
+func (c *Client) CreateBillingRequestFlow(flow *BillingRequestFlow) error {
+	return c.CreateBillingRequestFlowWithContext(context.Background(), flow)
+}
+
+// CreateBillingRequestFlowWithContext is CreateBillingRequestFlow with a context, which can cancel the request or set
+// its deadline
+func (c *Client) CreateBillingRequestFlowWithContext(ctx context.Context, flow *BillingRequestFlow) error {
+	wrapper := &billingRequestFlowWrapper{flow}
+	return c.create(ctx, billingRequestFlowEndpoint, wrapper, wrapper)
+}
+
+// InitialiseBillingRequestFlow returns the flow with the given ID to its initial state, clearing any details the
+// payer has entered so that they can start again
+func (c *Client) InitialiseBillingRequestFlow(id string) (*BillingRequestFlow, error) {
+	return c.InitialiseBillingRequestFlowWithContext(context.Background(), id)
+}
+
+// InitialiseBillingRequestFlowWithContext is InitialiseBillingRequestFlow with a context, which can cancel the request
+// or set its deadline
+func (c *Client) InitialiseBillingRequestFlowWithContext(ctx context.Context, id string) (*BillingRequestFlow, error) {
+	wrapper := &billingRequestFlowWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/initialise`, billingRequestFlowEndpoint, id)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequestFlow, nil
+}
@@ -0,0 +1,124 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const billingRequestFlowResponse = `{
+	"billing_request_flows": {
+		"id": "BRF123",
+		"authorisation_url": "https://pay.gocardless.com/billing/static/flow?id=BRF123",
+		"lock_customer_details": true,
+		"lock_bank_account": false,
+		"auto_fulfil": true,
+		"created_at": "2021-03-22T12:34:56.000Z",
+		"expires_at": "2021-03-29T12:34:56.000Z",
+		"redirect_uri": "https://example.com/return",
+		"exit_uri": "https://example.com/exit",
+		"links": {
+			"billing_request": "BRQ123"
+		}
+	}
+}`
+
+func TestClientCreateBillingRequestFlow(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(billingRequestFlowResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a flow with locked, prefilled customer details`, func() {
+				flow := &BillingRequestFlow{
+					RedirectURI:         `https://example.com/return`,
+					ExitURI:             `https://example.com/exit`,
+					LockCustomerDetails: true,
+					PrefilledCustomer:   &BillingRequestFlowPrefilledCustomer{GivenName: `Frank`, Email: `frank@example.com`},
+					Links:               &BillingRequestFlowLinks{BillingRequest: `BRQ123`},
+				}
+
+				Convey(`When I call the CreateBillingRequestFlow method`, func() {
+					err := client.CreateBillingRequestFlow(flow)
+
+					Convey(`Then the URL will use the billing request flows endpoint`, func() {
+						So(requestPath, ShouldEqual, billingRequestFlowEndpoint)
+					})
+
+					Convey(`Then the flow options will be sent`, func() {
+						sent := requestBody[`billing_request_flows`]
+						So(sent[`lock_customer_details`], ShouldEqual, true)
+						So(sent[`exit_uri`], ShouldEqual, `https://example.com/exit`)
+						So(sent[`prefilled_customer`].(map[string]interface{})[`given_name`], ShouldEqual, `Frank`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the authorisation URL will be populated`, func() {
+						So(flow.ID, ShouldEqual, `BRF123`)
+						So(flow.AuthorisationURL, ShouldEqual, `https://pay.gocardless.com/billing/static/flow?id=BRF123`)
+						So(flow.ExpiresAt, ShouldNotBeNil)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientInitialiseBillingRequestFlow(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(billingRequestFlowResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the InitialiseBillingRequestFlow method`, func() {
+				flow, err := client.InitialiseBillingRequestFlow(`BRF123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the initialise action`, func() {
+					So(requestPath, ShouldEqual, `/billing_request_flows/BRF123/actions/initialise`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the billing request will be linked`, func() {
+					So(flow.Links.BillingRequest, ShouldEqual, `BRQ123`)
+				})
+			})
+		})
+	})
+}
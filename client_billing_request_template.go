@@ -0,0 +1,84 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	billingRequestTemplateEndpoint = `/billing_request_templates`
+)
+
+// billingRequestTemplateWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the
+// remote API
+type billingRequestTemplateWrapper struct {
+	BillingRequestTemplate *BillingRequestTemplate `json:"billing_request_templates"`
+}
+
+// billingRequestTemplateListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type billingRequestTemplateListWrapper struct {
+	BillingRequestTemplates []*BillingRequestTemplate `json:"billing_request_templates"`
+}
+
+// CreateBillingRequestTemplate creates the template with the remote API. On success the template is updated with the
+// values returned by the API, including the AuthorisationURL to share with payers
+func (c *Client) CreateBillingRequestTemplate(template *BillingRequestTemplate) error {
+	return c.CreateBillingRequestTemplateWithContext(context.Background(), template)
+}
+
+// CreateBillingRequestTemplateWithContext is CreateBillingRequestTemplate with a context, which can cancel the request
+// or set its deadline
+func (c *Client) CreateBillingRequestTemplateWithContext(ctx context.Context, template *BillingRequestTemplate) error {
+	wrapper := &billingRequestTemplateWrapper{template}
+	return c.create(ctx, billingRequestTemplateEndpoint, wrapper, wrapper)
+}
+
+// GetBillingRequestTemplate retrieves the details of the template with the given ID
+func (c *Client) GetBillingRequestTemplate(id string) (*BillingRequestTemplate, error) {
+	return c.GetBillingRequestTemplateWithContext(context.Background(), id)
+}
+
+// GetBillingRequestTemplateWithContext is GetBillingRequestTemplate with a context, which can cancel the request or set
+// its deadline
+func (c *Client) GetBillingRequestTemplateWithContext(ctx context.Context, id string) (*BillingRequestTemplate, error) {
+	wrapper := &billingRequestTemplateWrapper{}
+	path := fmt.Sprintf(`%s/%s`, billingRequestTemplateEndpoint, id)
+	if err := c.execute(ctx, http.MethodGet, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequestTemplate, nil
+}
+
+// ListBillingRequestTemplates returns the templates matching the supplied options. The options may be nil
+func (c *Client) ListBillingRequestTemplates(options *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error) {
+	return c.ListBillingRequestTemplatesWithContext(context.Background(), options)
+}
+
+// ListBillingRequestTemplatesWithContext is ListBillingRequestTemplates with a context, which can cancel the request or
+// set its deadline
+func (c *Client) ListBillingRequestTemplatesWithContext(ctx context.Context, options *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error) {
+	wrapper := &billingRequestTemplateListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(billingRequestTemplateEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.BillingRequestTemplates, nil
+}
+
+// UpdateBillingRequestTemplate sends the template to the remote API, omitting the fields which are set by the API.
+// Billing requests which have already been created from the template are not changed. On success the template is
+// updated with the values returned by the API
+func (c *Client) UpdateBillingRequestTemplate(template *BillingRequestTemplate) error {
+	return c.UpdateBillingRequestTemplateWithContext(context.Background(), template)
+}
+
+// UpdateBillingRequestTemplateWithContext is UpdateBillingRequestTemplate with a context, which can cancel the request
+// or set its deadline
+func (c *Client) UpdateBillingRequestTemplateWithContext(ctx context.Context, template *BillingRequestTemplate) error {
+	update := *template
+	update.ID, update.AuthorisationURL = ``, ``
+	update.CreatedAt, update.UpdatedAt = nil, nil
+
+	path := fmt.Sprintf(`%s/%s`, billingRequestTemplateEndpoint, template.ID)
+	return c.execute(ctx, http.MethodPut, path, &billingRequestTemplateWrapper{&update}, &billingRequestTemplateWrapper{template})
+}
@@ -0,0 +1,130 @@
+package gocardless
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+const billingRequestTemplateResponse = `{
+	"billing_request_templates": {
+		"id": "BRT123",
+		"name": "12 Month Gold Plan",
+		"authorisation_url": "https://pay.gocardless.com/billing/static/brt?id=BRT123",
+		"mandate_request_currency": "GBP",
+		"mandate_request_scheme": "bacs",
+		"payment_request_amount": "6900",
+		"payment_request_currency": "GBP",
+		"payment_request_description": "One-time joining fee",
+		"redirect_uri": "https://example.com/return",
+		"metadata": {},
+		"created_at": "2021-01-01T12:00:00.000Z",
+		"updated_at": "2021-01-01T12:00:00.000Z"
+	}
+}`
+
+func TestClientCreateBillingRequestTemplate(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(billingRequestTemplateResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CreateBillingRequestTemplate method`, func() {
+				template := &BillingRequestTemplate{
+					Name:                   `12 Month Gold Plan`,
+					MandateRequestCurrency: `GBP`,
+					PaymentRequestAmount:   `6900`,
+					PaymentRequestCurrency: `GBP`,
+				}
+				err := client.CreateBillingRequestTemplate(template)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the billing request templates endpoint`, func() {
+					So(requestPath, ShouldEqual, billingRequestTemplateEndpoint)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the authorisation URL will be populated`, func() {
+					So(template.AuthorisationURL, ShouldEqual, `https://pay.gocardless.com/billing/static/brt?id=BRT123`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientUpdateBillingRequestTemplate(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+				w.Write([]byte(billingRequestTemplateResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a template which was returned by the API`, func() {
+				createdAt := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+				template := &BillingRequestTemplate{
+					ID:               `BRT123`,
+					AuthorisationURL: `https://pay.gocardless.com/billing/static/brt?id=BRT123`,
+					CreatedAt:        &createdAt,
+					Name:             `12 Month Gold Plan`,
+				}
+
+				Convey(`When I call the UpdateBillingRequestTemplate method`, func() {
+					err := client.UpdateBillingRequestTemplate(template)
+
+					Convey(`Then the request method will be PUT`, func() {
+						So(requestMethod, ShouldEqual, http.MethodPut)
+					})
+
+					Convey(`Then the URL will use the billing request templates endpoint and ID`, func() {
+						So(requestPath, ShouldEqual, `/billing_request_templates/BRT123`)
+					})
+
+					Convey(`Then the fields set by the API will not be sent`, func() {
+						So(requestBody, ShouldEqual, `{"billing_request_templates":{"name":"12 Month Gold Plan"}}`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the template will be updated from the response`, func() {
+						So(template.PaymentRequestDescription, ShouldEqual, `One-time joining fee`)
+					})
+				})
+			})
+		})
+	})
+}
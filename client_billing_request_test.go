@@ -0,0 +1,241 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const billingRequestResponse = `{
+	"billing_requests": {
+		"id": "BRQ123",
+		"created_at": "2021-03-22T12:34:56.000Z",
+		"status": "pending",
+		"mandate_request": {
+			"currency": "GBP",
+			"scheme": "bacs",
+			"links": {}
+		},
+		"payment_request": {
+			"description": "First payment",
+			"currency": "GBP",
+			"amount": 500,
+			"scheme": "faster_payments",
+			"links": {}
+		},
+		"metadata": {},
+		"links": {
+			"customer": "CU123",
+			"customer_billing_detail": "CBD123"
+		},
+		"actions": [
+			{
+				"type": "collect_customer_details",
+				"required": true,
+				"completes_actions": [],
+				"requires_actions": [],
+				"status": "pending"
+			},
+			{
+				"type": "collect_bank_account",
+				"required": true,
+				"completes_actions": ["choose_currency"],
+				"requires_actions": [],
+				"status": "pending"
+			}
+		],
+		"resources": {
+			"customer": {
+				"id": "CU123",
+				"email": "frank@example.com"
+			},
+			"customer_billing_detail": {
+				"id": "CBD123",
+				"country_code": "GB"
+			}
+		}
+	}
+}`
+
+func TestClientCreateBillingRequest(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]*BillingRequest
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(billingRequestResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a billing request for a mandate and an instant payment`, func() {
+				billingRequest := &BillingRequest{
+					MandateRequest: &BillingRequestMandateRequest{Currency: `GBP`},
+					PaymentRequest: &BillingRequestPaymentRequest{Amount: 500, Currency: `GBP`, Description: `First payment`},
+				}
+
+				Convey(`When I call the CreateBillingRequest method`, func() {
+					err := client.CreateBillingRequest(billingRequest)
+
+					Convey(`Then the URL will use the billing requests endpoint`, func() {
+						So(requestPath, ShouldEqual, billingRequestEndpoint)
+					})
+
+					Convey(`Then the mandate and payment requests will be sent`, func() {
+						So(requestBody[`billing_requests`].MandateRequest.Currency, ShouldEqual, `GBP`)
+						So(requestBody[`billing_requests`].PaymentRequest.Amount, ShouldEqual, 500)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the actions will be populated`, func() {
+						So(billingRequest.Status, ShouldEqual, BillingRequestPending)
+						So(len(billingRequest.Actions), ShouldEqual, 2)
+						So(billingRequest.Actions[1].Type, ShouldEqual, `collect_bank_account`)
+						So(billingRequest.Actions[1].CompletesActions, ShouldResemble, []string{`choose_currency`})
+					})
+
+					Convey(`Then the resources will be populated`, func() {
+						So(billingRequest.Resources.Customer.Email, ShouldEqual, `frank@example.com`)
+						So(billingRequest.Resources.CustomerBillingDetail.CountryCode, ShouldEqual, `GB`)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientBillingRequestActions(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+				w.Write([]byte(billingRequestResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CollectBillingRequestCustomerDetails method`, func() {
+				_, err := client.CollectBillingRequestCustomerDetails(`BRQ123`, &CollectCustomerDetailsRequest{
+					Customer:              &BillingRequestCustomer{Email: `frank@example.com`},
+					CustomerBillingDetail: &CustomerBillingDetail{CountryCode: `GB`},
+				})
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the collect_customer_details action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/collect_customer_details`)
+				})
+
+				Convey(`Then the details will be sent as the action data`, func() {
+					So(requestBody, ShouldEqual, `{"data":{"customer":{"email":"frank@example.com"},"customer_billing_detail":{"country_code":"GB"}}}`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey(`When I call the CollectBillingRequestBankAccount method`, func() {
+				client.CollectBillingRequestBankAccount(`BRQ123`, &CollectBankAccountRequest{IBAN: `GB60BARC20000055779911`})
+
+				Convey(`Then the URL will use the collect_bank_account action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/collect_bank_account`)
+				})
+
+				Convey(`Then the bank details will be sent as the action data`, func() {
+					So(requestBody, ShouldEqual, `{"data":{"iban":"GB60BARC20000055779911"}}`)
+				})
+			})
+
+			Convey(`When I call the ConfirmBillingRequestPayerDetails method`, func() {
+				client.ConfirmBillingRequestPayerDetails(`BRQ123`, &ConfirmPayerDetailsRequest{})
+
+				Convey(`Then the URL will use the confirm_payer_details action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/confirm_payer_details`)
+				})
+			})
+
+			Convey(`When I call the FulfilBillingRequest method without data`, func() {
+				client.FulfilBillingRequest(`BRQ123`, nil)
+
+				Convey(`Then the URL will use the fulfil action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/fulfil`)
+				})
+
+				Convey(`Then no request body will be sent`, func() {
+					So(requestBody, ShouldEqual, ``)
+				})
+			})
+
+			Convey(`When I call the NotifyBillingRequest method`, func() {
+				client.NotifyBillingRequest(`BRQ123`, &NotifyRequest{NotificationType: `email`})
+
+				Convey(`Then the notification type will be sent as the action data`, func() {
+					So(requestBody, ShouldEqual, `{"data":{"notification_type":"email"}}`)
+				})
+			})
+
+			Convey(`When I call the FallbackBillingRequest method`, func() {
+				client.FallbackBillingRequest(`BRQ123`)
+
+				Convey(`Then the URL will use the fallback action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/fallback`)
+				})
+			})
+
+			Convey(`When I call the ChooseBillingRequestCurrency method`, func() {
+				client.ChooseBillingRequestCurrency(`BRQ123`, &ChooseCurrencyRequest{Currency: `EUR`})
+
+				Convey(`Then the URL will use the choose_currency action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/choose_currency`)
+				})
+			})
+
+			Convey(`When I call the SelectBillingRequestInstitution method`, func() {
+				client.SelectBillingRequestInstitution(`BRQ123`, &SelectInstitutionRequest{CountryCode: `GB`, Institution: `monzo`})
+
+				Convey(`Then the institution will be sent as the action data`, func() {
+					So(requestBody, ShouldEqual, `{"data":{"country_code":"GB","institution":"monzo"}}`)
+				})
+			})
+
+			Convey(`When I call the CancelBillingRequest method`, func() {
+				_, err := client.CancelBillingRequest(`BRQ123`)
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/billing_requests/BRQ123/actions/cancel`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
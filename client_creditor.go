@@ -0,0 +1,102 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	creditorEndpoint = `/creditors`
+)
+
+// creditorWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type creditorWrapper struct {
+	Creditor *Creditor `json:"creditors"`
+}
+
+// creditorListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type creditorListWrapper struct {
+	Creditors []*Creditor `json:"creditors"`
+}
+
+// creditorUpdate holds the fields of a Creditor which may be changed after creation
+type creditorUpdate struct {
+	AddressLine1 string         `json:"address_line1,omitempty"`
+	AddressLine2 string         `json:"address_line2,omitempty"`
+	AddressLine3 string         `json:"address_line3,omitempty"`
+	City         string         `json:"city,omitempty"`
+	CountryCode  string         `json:"country_code,omitempty"`
+	Name         string         `json:"name,omitempty"`
+	PostalCode   string         `json:"postal_code,omitempty"`
+	Region       string         `json:"region,omitempty"`
+	Links        *CreditorLinks `json:"links,omitempty"`
+}
+
+// CreateCreditor creates the creditor with the remote API. Creditors may only be created by whitelabel partners, so
+// a RestrictedEndpointError is returned without contacting the API when the Client is using the LiveEnvironment or its
+// RemoteURL is BaseLiveURL. On success the creditor is updated with the values returned by the API
+func (c *Client) CreateCreditor(creditor *Creditor) error {
+	return c.CreateCreditorWithContext(context.Background(), creditor)
+}
+
+// CreateCreditorWithContext is CreateCreditor with a context, which can cancel the request or set its deadline
+func (c *Client) CreateCreditorWithContext(ctx context.Context, creditor *Creditor) error {
+	if c.isLive() {
+		return &RestrictedEndpointError{Endpoint: `Creditors: Create`, Environment: LiveEnvironment}
+	}
+
+	wrapper := &creditorWrapper{creditor}
+	return c.create(ctx, creditorEndpoint, wrapper, wrapper)
+}
+
+// GetCreditor retrieves the details of the creditor with the given ID
+func (c *Client) GetCreditor(id string) (*Creditor, error) {
+	return c.GetCreditorWithContext(context.Background(), id)
+}
+
+// GetCreditorWithContext is GetCreditor with a context, which can cancel the request or set its deadline
+func (c *Client) GetCreditorWithContext(ctx context.Context, id string) (*Creditor, error) {
+	wrapper := &creditorWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, creditorEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Creditor, nil
+}
+
+// ListCreditors returns the creditors matching the supplied options. The options may be nil
+func (c *Client) ListCreditors(options *CreditorListOptions) ([]*Creditor, error) {
+	return c.ListCreditorsWithContext(context.Background(), options)
+}
+
+// ListCreditorsWithContext is ListCreditors with a context, which can cancel the request or set its deadline
+func (c *Client) ListCreditorsWithContext(ctx context.Context, options *CreditorListOptions) ([]*Creditor, error) {
+	wrapper := &creditorListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(creditorEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Creditors, nil
+}
+
+// UpdateCreditor sends the name, address and default payout accounts of the creditor to the remote API. On success
+// the creditor is updated with the values returned by the API
+func (c *Client) UpdateCreditor(creditor *Creditor) error {
+	return c.UpdateCreditorWithContext(context.Background(), creditor)
+}
+
+// UpdateCreditorWithContext is UpdateCreditor with a context, which can cancel the request or set its deadline
+func (c *Client) UpdateCreditorWithContext(ctx context.Context, creditor *Creditor) error {
+	request := map[string]*creditorUpdate{`creditors`: {
+		AddressLine1: creditor.AddressLine1,
+		AddressLine2: creditor.AddressLine2,
+		AddressLine3: creditor.AddressLine3,
+		City:         creditor.City,
+		CountryCode:  creditor.CountryCode,
+		Name:         creditor.Name,
+		PostalCode:   creditor.PostalCode,
+		Region:       creditor.Region,
+		Links:        creditor.Links,
+	}}
+	path := fmt.Sprintf(`%s/%s`, creditorEndpoint, creditor.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &creditorWrapper{creditor})
+}
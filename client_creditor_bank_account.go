@@ -0,0 +1,82 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	creditorBankAccountEndpoint = `/creditor_bank_accounts`
+)
+
+// creditorBankAccountWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote
+// API
+type creditorBankAccountWrapper struct {
+	CreditorBankAccount *CreditorBankAccount `json:"creditor_bank_accounts"`
+}
+
+// creditorBankAccountListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type creditorBankAccountListWrapper struct {
+	CreditorBankAccounts []*CreditorBankAccount `json:"creditor_bank_accounts"`
+}
+
+// CreateCreditorBankAccount creates the bank account with the remote API. The Links.Creditor field must be set to the
+// creditor that owns the account. On success the account is updated with the values returned by the API
+func (c *Client) CreateCreditorBankAccount(account *CreditorBankAccount) error {
+	return c.CreateCreditorBankAccountWithContext(context.Background(), account)
+}
+
+// CreateCreditorBankAccountWithContext is CreateCreditorBankAccount with a context, which can cancel the request or set
+// its deadline
+func (c *Client) CreateCreditorBankAccountWithContext(ctx context.Context, account *CreditorBankAccount) error {
+	wrapper := &creditorBankAccountWrapper{account}
+	return c.create(ctx, creditorBankAccountEndpoint, wrapper, wrapper)
+}
+
+// GetCreditorBankAccount retrieves the details of the bank account with the given ID
+func (c *Client) GetCreditorBankAccount(id string) (*CreditorBankAccount, error) {
+	return c.GetCreditorBankAccountWithContext(context.Background(), id)
+}
+
+// GetCreditorBankAccountWithContext is GetCreditorBankAccount with a context, which can cancel the request or set its
+// deadline
+func (c *Client) GetCreditorBankAccountWithContext(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	wrapper := &creditorBankAccountWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, creditorBankAccountEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CreditorBankAccount, nil
+}
+
+// ListCreditorBankAccounts returns the bank accounts matching the supplied options. The options may be nil
+func (c *Client) ListCreditorBankAccounts(options *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error) {
+	return c.ListCreditorBankAccountsWithContext(context.Background(), options)
+}
+
+// ListCreditorBankAccountsWithContext is ListCreditorBankAccounts with a context, which can cancel the request or set
+// its deadline
+func (c *Client) ListCreditorBankAccountsWithContext(ctx context.Context, options *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error) {
+	wrapper := &creditorBankAccountListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(creditorBankAccountEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CreditorBankAccounts, nil
+}
+
+// DisableCreditorBankAccount disables the bank account with the given ID, after which it can no longer receive
+// payouts. A disabled bank account cannot be re-enabled
+func (c *Client) DisableCreditorBankAccount(id string) (*CreditorBankAccount, error) {
+	return c.DisableCreditorBankAccountWithContext(context.Background(), id)
+}
+
+// DisableCreditorBankAccountWithContext is DisableCreditorBankAccount with a context, which can cancel the request or
+// set its deadline
+func (c *Client) DisableCreditorBankAccountWithContext(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	wrapper := &creditorBankAccountWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/disable`, creditorBankAccountEndpoint, id)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CreditorBankAccount, nil
+}
@@ -0,0 +1,115 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const creditorBankAccountResponse = `{
+	"creditor_bank_accounts": {
+		"id": "BA123",
+		"created_at": "2014-05-27T12:43:17.000Z",
+		"account_holder_name": "Nude Wines",
+		"account_number_ending": "11",
+		"country_code": "GB",
+		"currency": "GBP",
+		"bank_name": "BARCLAYS BANK PLC",
+		"metadata": {},
+		"enabled": true,
+		"links": {
+			"creditor": "CR123"
+		}
+	}
+}`
+
+func TestClientCreateCreditorBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(creditorBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a bank account to be used as the default payout account`, func() {
+				account := &CreditorBankAccount{
+					AccountHolderName:         `Nude Wines`,
+					IBAN:                      `GB60BARC20000055779911`,
+					SetAsDefaultPayoutAccount: true,
+					Links:                     &CreditorBankAccountLinks{Creditor: `CR123`},
+				}
+
+				Convey(`When I call the CreateCreditorBankAccount method`, func() {
+					err := client.CreateCreditorBankAccount(account)
+
+					Convey(`Then the URL will use the creditor bank accounts endpoint`, func() {
+						So(requestPath, ShouldEqual, creditorBankAccountEndpoint)
+					})
+
+					Convey(`Then the default payout account option will be sent`, func() {
+						So(requestBody[`creditor_bank_accounts`][`set_as_default_payout_account`], ShouldEqual, true)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the bank account will be populated from the response`, func() {
+						So(account.ID, ShouldEqual, `BA123`)
+						So(account.Links.Creditor, ShouldEqual, `CR123`)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientDisableCreditorBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(creditorBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the DisableCreditorBankAccount method`, func() {
+				_, err := client.DisableCreditorBankAccount(`BA123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the disable action`, func() {
+					So(requestPath, ShouldEqual, `/creditor_bank_accounts/BA123/actions/disable`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,150 @@
+package gocardless
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const creditorResponse = `{
+	"creditors": {
+		"id": "CR123",
+		"created_at": "2017-02-16T12:34:56.000Z",
+		"name": "Acme",
+		"address_line1": "27 Acer Road",
+		"city": "London",
+		"postal_code": "E8 3GX",
+		"country_code": "GB",
+		"logo_url": null,
+		"verification_status": "successful",
+		"scheme_identifiers": [
+			{
+				"name": "GoCardless",
+				"scheme": "bacs",
+				"reference": "420042",
+				"minimum_advance_notice": 3,
+				"can_specify_mandate_reference": false,
+				"currency": "GBP"
+			}
+		],
+		"links": {
+			"default_gbp_payout_account": "BA123"
+		}
+	}
+}`
+
+func TestClientCreateCreditor(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			isCalled := false
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				isCalled = true
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(creditorResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And the client is using the sandbox environment`, func() {
+				client.Environment = SandboxEnvironment
+
+				Convey(`When I call the CreateCreditor method`, func() {
+					creditor := &Creditor{Name: `Acme`}
+					err := client.CreateCreditor(creditor)
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the creditor will be populated from the response`, func() {
+						So(creditor.ID, ShouldEqual, `CR123`)
+						So(creditor.SchemeIdentifiers[0].Scheme, ShouldEqual, `bacs`)
+						So(creditor.SchemeIdentifiers[0].MinimumAdvanceNotice, ShouldEqual, 3)
+					})
+				})
+			})
+
+			Convey(`And the client is using the live environment`, func() {
+				client.Environment = LiveEnvironment
+
+				Convey(`When I call the CreateCreditor method`, func() {
+					err := client.CreateCreditor(&Creditor{Name: `Acme`})
+
+					Convey(`Then the error will be a RestrictedEndpointError`, func() {
+						restricted, ok := err.(*RestrictedEndpointError)
+						So(ok, ShouldBeTrue)
+						So(restricted.Environment, ShouldEqual, LiveEnvironment)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+		})
+
+		Convey(`And the client is using the sandbox environment with the live base URL`, func() {
+			client.Environment = SandboxEnvironment
+			WithBaseURL(BaseLiveURL + `/`)(client)
+
+			Convey(`When I call the CreateCreditor method`, func() {
+				err := client.CreateCreditor(&Creditor{Name: `Acme`})
+
+				Convey(`Then the error will be a RestrictedEndpointError for the live environment`, func() {
+					restricted, ok := err.(*RestrictedEndpointError)
+					So(ok, ShouldBeTrue)
+					So(restricted.Environment, ShouldEqual, LiveEnvironment)
+				})
+			})
+		})
+	})
+}
+
+func TestClientUpdateCreditor(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+				w.Write([]byte(creditorResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the UpdateCreditor method`, func() {
+				creditor := &Creditor{ID: `CR123`, Name: `Acme`, VerificationStatus: `successful`}
+				err := client.UpdateCreditor(creditor)
+
+				Convey(`Then the request method will be PUT`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPut)
+				})
+
+				Convey(`Then the URL will use the creditors endpoint and ID`, func() {
+					So(requestPath, ShouldEqual, `/creditors/CR123`)
+				})
+
+				Convey(`Then read only fields will not be sent`, func() {
+					So(requestBody, ShouldEqual, `{"creditors":{"name":"Acme"}}`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -1,10 +1,8 @@
 package gocardless
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
 )
 
@@ -17,112 +15,83 @@ type customerWrapper struct {
 	Customer *Customer `json:"customers"`
 }
 
-//
-func (c *Client) CreateCustomer(customer *Customer) error {
-	custRequest := &customerWrapper{customer}
-
-	data, err := json.Marshal(custRequest)
-	if err != nil {
-		return err
-	}
-
-	req, err := c.newRequest(customerEndpoint, http.MethodPost, data)
-	if err != nil {
-		return err
-	}
-
-	resp, err := c.do(req)
-	if err != nil {
-		return err
-	}
-
-	respBody, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
-	}
+// customerListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type customerListWrapper struct {
+	Customers []*Customer `json:"customers"`
+}
 
-	if resp.StatusCode != http.StatusCreated {
-		return errors.New(fmt.Sprintf("%s WAS NOT CREATED", c.decodeError(respBody).Error()))
-	}
+// CreateCustomer creates the customer with the remote API. On success the customer is updated with the values
+// returned by the API
+func (c *Client) CreateCustomer(customer *Customer) error {
+	return c.CreateCustomerWithContext(context.Background(), customer)
+}
 
-	if err := json.Unmarshal(respBody, custRequest); err != nil {
-		return err
-	}
-	return nil
+// CreateCustomerWithContext is CreateCustomer with a context, which can cancel the request or set its deadline
+func (c *Client) CreateCustomerWithContext(ctx context.Context, customer *Customer) error {
+	wrapper := &customerWrapper{customer}
+	return c.create(ctx, customerEndpoint, wrapper, wrapper)
 }
 
+// GetCustomer retrieves the details of the customer with the given ID
 func (c *Client) GetCustomer(id string) (*Customer, error) {
-	request, err := c.newRequest(fmt.Sprintf(`%s/%s`, customerEndpoint, id), http.MethodGet, nil)
-	if err != nil {
-		return nil, err
-	}
-	response, err := c.do(request)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return c.GetCustomerWithContext(context.Background(), id)
+}
 
+// GetCustomerWithContext is GetCustomer with a context, which can cancel the request or set its deadline
+func (c *Client) GetCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
 	wrapper := &customerWrapper{}
-	if err := json.Unmarshal(body, wrapper); err != nil {
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, customerEndpoint, id), nil, wrapper); err != nil {
 		return nil, err
 	}
-
 	return wrapper.Customer, nil
 }
 
+// ListCustomer returns the customers
 func (c *Client) ListCustomer() ([]*Customer, error) {
-	req, err := c.newRequest(customerEndpoint, http.MethodGet, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
+	return c.ListCustomerWithContext(context.Background())
+}
 
-	customerList := []*Customer{}
-	if err := json.Unmarshal(data, customerList); err != nil {
+// ListCustomerWithContext is ListCustomer with a context, which can cancel the request or set its deadline
+func (c *Client) ListCustomerWithContext(ctx context.Context) ([]*Customer, error) {
+	wrapper := &customerListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, customerEndpoint, nil, wrapper); err != nil {
 		return nil, err
 	}
-
-	return customerList, nil
+	return wrapper.Customers, nil
 }
 
+// UpdateCustomer sends the customer to the remote API. On success the customer is updated with the values returned
+// by the API
 func (c *Client) UpdateCustomer(customer *Customer) error {
-	custRequest := &customerWrapper{customer}
-
-	data, err := json.Marshal(custRequest)
-	if err != nil {
-		return err
-	}
+	return c.UpdateCustomerWithContext(context.Background(), customer)
+}
 
-	req, err := c.newRequest(fmt.Sprintf("%s/%s", customerEndpoint, customer.ID), http.MethodPut, data)
-	if err != nil {
-		return err
-	}
+// UpdateCustomerWithContext is UpdateCustomer with a context, which can cancel the request or set its deadline
+func (c *Client) UpdateCustomerWithContext(ctx context.Context, customer *Customer) error {
+	wrapper := &customerWrapper{customer}
+	return c.execute(ctx, http.MethodPut, fmt.Sprintf(`%s/%s`, customerEndpoint, customer.ID), wrapper, wrapper)
+}
 
-	resp, err := c.do(req)
-	if err != nil {
-		return err
-	}
+// RemoveCustomer permanently removes the personal data of the customer with the given ID, and cancels any of the
+// customer's active mandates, subscriptions and payments. This cannot be undone. As the request is destructive it is
+// sent with an Idempotency-Key, so that it is only acted upon once. The returned customer has its personal data
+// blanked and LooksRemoved will report true
+func (c *Client) RemoveCustomer(id string) (*Customer, error) {
+	return c.RemoveCustomerWithContext(context.Background(), id)
+}
 
-	respBody, err := ioutil.ReadAll(resp.Body)
+// RemoveCustomerWithContext is RemoveCustomer with a context, which can cancel the request or set its deadline. The
+// Idempotency-Key may be set with WithIdempotencyKey
+func (c *Client) RemoveCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
+	header, err := idempotencyHeader(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := json.Unmarshal(respBody, custRequest); err != nil {
-		return err
+	wrapper := &customerWrapper{}
+	path := fmt.Sprintf(`%s/%s`, customerEndpoint, id)
+	if err := c.executeWithHeader(ctx, http.MethodDelete, path, header, nil, wrapper); err != nil {
+		return nil, err
 	}
-	return nil
+	return wrapper.Customer, nil
 }
@@ -0,0 +1,134 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	customerBankAccountEndpoint = `/customer_bank_accounts`
+)
+
+// customerBankAccountWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote
+// API
+type customerBankAccountWrapper struct {
+	CustomerBankAccount *CustomerBankAccount `json:"customer_bank_accounts"`
+}
+
+// customerBankAccountListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type customerBankAccountListWrapper struct {
+	CustomerBankAccounts []*CustomerBankAccount `json:"customer_bank_accounts"`
+}
+
+// CreateCustomerBankAccount creates the bank account with the remote API. The Links.Customer field must be set to the
+// customer that owns the account. Unless a Links.CustomerBankAccountToken is used in their place, the bank details are
+// checked with ValidateBankDetails and any problems are returned without contacting the API. On success the account is
+// updated with the values returned by the API
+func (c *Client) CreateCustomerBankAccount(account *CustomerBankAccount) error {
+	return c.CreateCustomerBankAccountWithContext(context.Background(), account)
+}
+
+// CreateCustomerBankAccountWithContext is CreateCustomerBankAccount with a context, which can cancel the request or set
+// its deadline
+func (c *Client) CreateCustomerBankAccountWithContext(ctx context.Context, account *CustomerBankAccount) error {
+	if err := validateCustomerBankAccount(account); err != nil {
+		return err
+	}
+
+	wrapper := &customerBankAccountWrapper{account}
+	return c.create(ctx, customerBankAccountEndpoint, wrapper, wrapper)
+}
+
+// validateCustomerBankAccount checks the bank details of the account with ValidateBankDetails, with the RequestPointer
+// of each problem referring to the customer bank account rather than a bank details lookup
+func validateCustomerBankAccount(account *CustomerBankAccount) error {
+	if account == nil {
+		return newValidationError(&ErrorDetail{
+			Message:        `must be provided`,
+			Field:          `customer_bank_accounts`,
+			RequestPointer: `/customer_bank_accounts`,
+		})
+	}
+	if account.Links != nil && account.Links.CustomerBankAccountToken != `` {
+		return nil
+	}
+
+	err := ValidateBankDetails(&BankDetails{
+		AccountNumber: account.AccountNumber,
+		BankCode:      account.BankCode,
+		BranchCode:    account.BranchCode,
+		CountryCode:   account.CountryCode,
+		IBAN:          account.IBAN,
+	})
+	var validationErr ValidationError
+	if errors.As(err, &validationErr) {
+		for _, detail := range validationErr.Err.Details {
+			detail.RequestPointer = fmt.Sprintf(`/customer_bank_accounts/%s`, detail.Field)
+		}
+	}
+	return err
+}
+
+// GetCustomerBankAccount retrieves the details of the bank account with the given ID
+func (c *Client) GetCustomerBankAccount(id string) (*CustomerBankAccount, error) {
+	return c.GetCustomerBankAccountWithContext(context.Background(), id)
+}
+
+// GetCustomerBankAccountWithContext is GetCustomerBankAccount with a context, which can cancel the request or set its
+// deadline
+func (c *Client) GetCustomerBankAccountWithContext(ctx context.Context, id string) (*CustomerBankAccount, error) {
+	wrapper := &customerBankAccountWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, customerBankAccountEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CustomerBankAccount, nil
+}
+
+// ListCustomerBankAccounts returns the bank accounts matching the supplied options. The options may be nil
+func (c *Client) ListCustomerBankAccounts(options *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error) {
+	return c.ListCustomerBankAccountsWithContext(context.Background(), options)
+}
+
+// ListCustomerBankAccountsWithContext is ListCustomerBankAccounts with a context, which can cancel the request or set
+// its deadline
+func (c *Client) ListCustomerBankAccountsWithContext(ctx context.Context, options *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error) {
+	wrapper := &customerBankAccountListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(customerBankAccountEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CustomerBankAccounts, nil
+}
+
+// UpdateCustomerBankAccount sends the Metadata of the account to the remote API, which is the only field that may be
+// updated. On success the account is updated with the values returned by the API
+func (c *Client) UpdateCustomerBankAccount(account *CustomerBankAccount) error {
+	return c.UpdateCustomerBankAccountWithContext(context.Background(), account)
+}
+
+// UpdateCustomerBankAccountWithContext is UpdateCustomerBankAccount with a context, which can cancel the request or set
+// its deadline
+func (c *Client) UpdateCustomerBankAccountWithContext(ctx context.Context, account *CustomerBankAccount) error {
+	request := map[string]*metadataUpdate{`customer_bank_accounts`: {account.Metadata}}
+
+	path := fmt.Sprintf(`%s/%s`, customerBankAccountEndpoint, account.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &customerBankAccountWrapper{account})
+}
+
+// DisableCustomerBankAccount immediately cancels all associated mandates and cancellable payments, and disables the
+// bank account. A disabled bank account cannot be re-enabled
+func (c *Client) DisableCustomerBankAccount(id string) (*CustomerBankAccount, error) {
+	return c.DisableCustomerBankAccountWithContext(context.Background(), id)
+}
+
+// DisableCustomerBankAccountWithContext is DisableCustomerBankAccount with a context, which can cancel the request or
+// set its deadline
+func (c *Client) DisableCustomerBankAccountWithContext(ctx context.Context, id string) (*CustomerBankAccount, error) {
+	wrapper := &customerBankAccountWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/disable`, customerBankAccountEndpoint, id)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.CustomerBankAccount, nil
+}
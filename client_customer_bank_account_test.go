@@ -0,0 +1,349 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const customerBankAccountResponse = `{
+	"customer_bank_accounts": {
+		"id": "BA123",
+		"created_at": "2014-05-08T17:01:06.000Z",
+		"account_number_ending": "11",
+		"account_holder_name": "Frank Osborne",
+		"account_type": null,
+		"country_code": "GB",
+		"currency": "GBP",
+		"bank_name": "BARCLAYS BANK PLC",
+		"metadata": {},
+		"enabled": true,
+		"links": {
+			"customer": "CU123"
+		}
+	}
+}`
+
+func TestClientCreateCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(customerBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a bank account with local details`, func() {
+				account := &CustomerBankAccount{
+					AccountHolderName: `Frank Osborne`,
+					AccountNumber:     `55779911`,
+					BranchCode:        `200000`,
+					CountryCode:       `GB`,
+					Links:             &CustomerBankAccountLinks{Customer: `CU123`},
+				}
+
+				Convey(`When I call the CreateCustomerBankAccount method`, func() {
+					err := client.CreateCustomerBankAccount(account)
+
+					Convey(`Then the request method will be POST`, func() {
+						So(requestMethod, ShouldEqual, http.MethodPost)
+					})
+
+					Convey(`Then the URL will use the customer bank accounts endpoint`, func() {
+						So(requestPath, ShouldEqual, customerBankAccountEndpoint)
+					})
+
+					Convey(`Then the local details will be sent`, func() {
+						sent := requestBody[`customer_bank_accounts`]
+						So(sent[`account_number`], ShouldEqual, `55779911`)
+						So(sent[`branch_code`], ShouldEqual, `200000`)
+					})
+
+					Convey(`Then the customer link will be sent`, func() {
+						links := requestBody[`customer_bank_accounts`][`links`].(map[string]interface{})
+						So(links[`customer`], ShouldEqual, `CU123`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the bank account will be populated from the response`, func() {
+						So(account.ID, ShouldEqual, `BA123`)
+						So(account.AccountNumberEnding, ShouldEqual, `11`)
+						So(account.Enabled, ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey(`And I have a server which records whether it is called`, func() {
+			isCalled := false
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				isCalled = true
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(customerBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a bank account with a sort code of the wrong length`, func() {
+				account := &CustomerBankAccount{AccountNumber: `55779911`, BranchCode: `2000`, CountryCode: `GB`}
+
+				Convey(`When I call the CreateCustomerBankAccount method`, func() {
+					err := client.CreateCustomerBankAccount(account)
+
+					Convey(`Then a validation error will be returned for the customer bank account`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].Field, ShouldEqual, `branch_code`)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/customer_bank_accounts/branch_code`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have a nil bank account`, func() {
+				Convey(`When I call the CreateCustomerBankAccount method`, func() {
+					err := client.CreateCustomerBankAccount(nil)
+
+					Convey(`Then a validation error will be returned for the customer bank account`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/customer_bank_accounts`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have a bank account created from a customer bank account token`, func() {
+				account := &CustomerBankAccount{Links: &CustomerBankAccountLinks{CustomerBankAccountToken: `BAT123`}}
+
+				Convey(`When I call the CreateCustomerBankAccount method`, func() {
+					err := client.CreateCustomerBankAccount(account)
+
+					Convey(`Then the bank details will not be checked`, func() {
+						So(err, ShouldBeNil)
+						So(isCalled, ShouldBeTrue)
+					})
+				})
+			})
+		})
+
+		Convey(`And I have a server which returns a validation error`, func() {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{
+					"error": {
+						"message": "Validation failed",
+						"type": "validation_failed",
+						"code": 422,
+						"request_id": "dd50eaaf-8213-48fe-90d6-5466872efbc4",
+						"errors": [{
+							"message": "must be a number",
+							"field": "branch_code",
+							"request_pointer": "/customer_bank_accounts/branch_code"
+						}]
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CreateCustomerBankAccount method`, func() {
+				err := client.CreateCustomerBankAccount(&CustomerBankAccount{IBAN: `GB60BARC20000055779911`})
+
+				Convey(`Then the error will be the decoded GoCardless error`, func() {
+					validationErr, ok := err.(ValidationError)
+					So(ok, ShouldBeTrue)
+					gcErr := validationErr.Err
+					So(gcErr.Type, ShouldEqual, `validation_failed`)
+					So(gcErr.Details[0].Field, ShouldEqual, `branch_code`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientGetCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+
+				w.Write([]byte(customerBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the GetCustomerBankAccount method`, func() {
+				account, err := client.GetCustomerBankAccount(`BA123`)
+
+				Convey(`Then the request method will be GET`, func() {
+					So(requestMethod, ShouldEqual, http.MethodGet)
+				})
+
+				Convey(`Then the URL will use the customer bank accounts endpoint and ID`, func() {
+					So(requestPath, ShouldEqual, fmt.Sprintf(`%s/%s`, customerBankAccountEndpoint, `BA123`))
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the customer link will be populated`, func() {
+					So(account.Links.Customer, ShouldEqual, `CU123`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientListCustomerBankAccounts(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestQuery string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.RawQuery
+
+				w.Write([]byte(`{
+					"customer_bank_accounts": [{"id": "BA123"}, {"id": "BA456"}],
+					"meta": {"cursors": {"before": null, "after": null}, "limit": 50}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListCustomerBankAccounts method with a customer filter`, func() {
+				accounts, err := client.ListCustomerBankAccounts(&CustomerBankAccountListOptions{Customer: `CU123`})
+
+				Convey(`Then the customer will be sent as a query parameter`, func() {
+					So(requestQuery, ShouldEqual, `customer=CU123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then both accounts will be returned`, func() {
+					So(len(accounts), ShouldEqual, 2)
+				})
+			})
+
+			Convey(`When I call the ListCustomerBankAccounts method without options`, func() {
+				client.ListCustomerBankAccounts(nil)
+
+				Convey(`Then no query parameters will be sent`, func() {
+					So(requestQuery, ShouldEqual, ``)
+				})
+			})
+		})
+	})
+}
+
+func TestClientUpdateCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+
+				w.Write([]byte(customerBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the UpdateCustomerBankAccount method`, func() {
+				account := &CustomerBankAccount{ID: `BA123`, BankName: `BARCLAYS`, Metadata: map[string]string{`key`: `value`}}
+				err := client.UpdateCustomerBankAccount(account)
+
+				Convey(`Then the request method will be PUT`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPut)
+				})
+
+				Convey(`Then only the metadata will be sent`, func() {
+					So(requestBody, ShouldEqual, `{"customer_bank_accounts":{"metadata":{"key":"value"}}}`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
+
+func TestClientDisableCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+
+				w.Write([]byte(customerBankAccountResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the DisableCustomerBankAccount method`, func() {
+				_, err := client.DisableCustomerBankAccount(`BA123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the disable action`, func() {
+					So(requestPath, ShouldEqual, `/customer_bank_accounts/BA123/actions/disable`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
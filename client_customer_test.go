@@ -20,6 +20,9 @@ func TestClientCreateCustomer(t *testing.T) {
 			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 				requestMethod = req.Method
 				requestPath = req.URL.Path
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"customers": {"id": "CU001"}}`))
 			}))
 
 			client.RemoteURL = srv.URL
@@ -114,3 +117,163 @@ func TestClientGetCustomer(t *testing.T) {
 		})
 	})
 }
+
+func TestClientListCustomer(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a list of customers`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+
+				w.Write([]byte(`{
+									"customers": [
+										{"id": "CU123", "given_name": "Frank"},
+										{"id": "CU456", "given_name": "Jane"}
+									],
+									"meta": {"cursors": {"before": null, "after": null}, "limit": 50}
+								}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListCustomer method`, func() {
+				customers, err := client.ListCustomer()
+
+				Convey(`Then the request method will be GET`, func() {
+					So(requestMethod, ShouldEqual, http.MethodGet)
+				})
+
+				Convey(`Then the URL will use the customers endpoint`, func() {
+					So(requestPath, ShouldEqual, customerEndpoint)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the customers will be unwrapped from the response`, func() {
+					So(customers, ShouldHaveLength, 2)
+					So(customers[0].ID, ShouldEqual, `CU123`)
+					So(customers[1].ID, ShouldEqual, `CU456`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientRemoveCustomer(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a removed customer`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestHeader http.Header
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				requestHeader = req.Header
+
+				w.Write([]byte(`{
+									"customers": {
+										"id": "CU123",
+										"created_at": "2014-05-08T17:01:06.000Z",
+										"email": null,
+										"given_name": null,
+										"family_name": null,
+										"company_name": null,
+										"address_line1": null,
+										"city": null,
+										"postal_code": null,
+										"country_code": null,
+										"language": "en",
+										"metadata": {}
+									}
+								}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the RemoveCustomer method`, func() {
+				customer, err := client.RemoveCustomer(`CU123`)
+
+				Convey(`Then the request method will be DELETE`, func() {
+					So(requestMethod, ShouldEqual, http.MethodDelete)
+				})
+
+				Convey(`Then the URL will use the customers endpoint and customer ID`, func() {
+					So(requestPath, ShouldEqual, fmt.Sprintf("%s/%s", customerEndpoint, `CU123`))
+				})
+
+				Convey(`Then an Idempotency-Key will be sent`, func() {
+					So(requestHeader.Get(`Idempotency-Key`), ShouldHaveLength, 36)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the customer will be recognised as removed`, func() {
+					So(customer.ID, ShouldEqual, `CU123`)
+					So(customer.LooksRemoved(), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func TestCustomerLooksRemoved(t *testing.T) {
+	Convey(`Given I have a customer with a name`, t, func() {
+		customer := &Customer{ID: `CU123`, GivenName: `Frank`, FamilyName: `Osborne`}
+
+		Convey(`Then the customer will not look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeFalse)
+		})
+	})
+
+	Convey(`Given I have a customer with only a company name`, t, func() {
+		customer := &Customer{ID: `CU123`, CompanyName: `Acme`}
+
+		Convey(`Then the customer will not look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeFalse)
+		})
+	})
+
+	Convey(`Given I have a customer with only an email`, t, func() {
+		customer := &Customer{ID: `CU123`, Email: `user@example.com`}
+
+		Convey(`Then the customer will not look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeFalse)
+		})
+	})
+
+	Convey(`Given I have a customer with only a family name`, t, func() {
+		customer := &Customer{ID: `CU123`, FamilyName: `Osborne`}
+
+		Convey(`Then the customer will not look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeFalse)
+		})
+	})
+
+	Convey(`Given I have a customer whose personal data is blank but whose address remains`, t, func() {
+		customer := &Customer{ID: `CU123`, AddressLine1: `27 Acer Road`, City: `London`}
+
+		Convey(`Then the customer will look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeTrue)
+		})
+	})
+
+	Convey(`Given I have a customer which has not been created`, t, func() {
+		customer := &Customer{}
+
+		Convey(`Then the customer will not look removed`, func() {
+			So(customer.LooksRemoved(), ShouldBeFalse)
+		})
+	})
+}
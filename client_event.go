@@ -0,0 +1,52 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	eventEndpoint = `/events`
+)
+
+// eventWrapper is a utility struct used to unwrap the JSON response of the remote API
+type eventWrapper struct {
+	Event *Event `json:"events"`
+}
+
+// eventListWrapper is a utility struct used to unwrap the JSON response of the list endpoint, including any linked
+// resources
+type eventListWrapper struct {
+	Events []*Event `json:"events"`
+	Linked *Linked  `json:"linked"`
+}
+
+// GetEvent retrieves the details of the event with the given ID
+func (c *Client) GetEvent(id string) (*Event, error) {
+	return c.GetEventWithContext(context.Background(), id)
+}
+
+// GetEventWithContext is GetEvent with a context, which can cancel the request or set its deadline
+func (c *Client) GetEventWithContext(ctx context.Context, id string) (*Event, error) {
+	wrapper := &eventWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, eventEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Event, nil
+}
+
+// ListEvents returns the events matching the supplied options. The options may be nil. When the Include option is
+// set the linked resources are also returned, otherwise the Linked value will be empty
+func (c *Client) ListEvents(options *EventListOptions) ([]*Event, *Linked, error) {
+	return c.ListEventsWithContext(context.Background(), options)
+}
+
+// ListEventsWithContext is ListEvents with a context, which can cancel the request or set its deadline
+func (c *Client) ListEventsWithContext(ctx context.Context, options *EventListOptions) ([]*Event, *Linked, error) {
+	wrapper := &eventListWrapper{Linked: &Linked{}}
+	if err := c.execute(ctx, http.MethodGet, withQuery(eventEndpoint, options), nil, wrapper); err != nil {
+		return nil, nil, err
+	}
+	return wrapper.Events, wrapper.Linked, nil
+}
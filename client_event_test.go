@@ -0,0 +1,182 @@
+package gocardless
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+func TestClientGetEvent(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				w.Write([]byte(`{
+					"events": {
+						"id": "EV123",
+						"created_at": "2014-04-08T17:01:06.000Z",
+						"resource_type": "mandates",
+						"action": "cancelled",
+						"details": {
+							"cause": "bank_account_disabled",
+							"description": "Customer's bank account closed",
+							"origin": "bank",
+							"reason_code": "ADDACS-B",
+							"scheme": "bacs"
+						},
+						"metadata": {},
+						"links": {
+							"mandate": "MD123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the GetEvent method`, func() {
+				event, err := client.GetEvent(`EV123`)
+
+				Convey(`Then the URL will use the events endpoint and ID`, func() {
+					So(requestPath, ShouldEqual, `/events/EV123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the event will be populated`, func() {
+					So(event.Action, ShouldEqual, `cancelled`)
+					So(event.ResourceType, ShouldEqual, ResourceTypeMandates)
+					So(event.Links.Mandate, ShouldEqual, `MD123`)
+				})
+
+				Convey(`Then the details will be populated`, func() {
+					So(event.Details.Cause, ShouldEqual, `bank_account_disabled`)
+					So(event.Details.Origin, ShouldEqual, `bank`)
+					So(event.Details.ReasonCode, ShouldEqual, `ADDACS-B`)
+					So(event.Details.Scheme, ShouldEqual, `bacs`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientListEvents(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns events with linked payments`, func() {
+			var requestQuery url.Values
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.Query()
+				w.Write([]byte(`{
+					"events": [
+						{
+							"id": "EV123",
+							"resource_type": "payments",
+							"action": "confirmed",
+							"links": { "payment": "PM123" }
+						}
+					],
+					"linked": {
+						"payments": [
+							{ "id": "PM123", "amount": 100, "status": "confirmed" }
+						]
+					},
+					"meta": { "limit": 50 }
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListEvents method with filters`, func() {
+				createdAfter := time.Date(2014, 4, 1, 0, 0, 0, 0, time.UTC)
+				events, linked, err := client.ListEvents(&EventListOptions{
+					ResourceType: ResourceTypePayments,
+					Action:       `confirmed`,
+					CreatedAfter: &createdAfter,
+					Include:      `payment`,
+				})
+
+				Convey(`Then the filters will be sent as query parameters`, func() {
+					So(requestQuery.Get(`resource_type`), ShouldEqual, `payments`)
+					So(requestQuery.Get(`action`), ShouldEqual, `confirmed`)
+					So(requestQuery.Get(`created_at[gt]`), ShouldEqual, `2014-04-01T00:00:00Z`)
+					So(requestQuery.Get(`include`), ShouldEqual, `payment`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the events will be returned`, func() {
+					So(len(events), ShouldEqual, 1)
+					So(events[0].Links.Payment, ShouldEqual, `PM123`)
+				})
+
+				Convey(`Then the linked payments will be typed`, func() {
+					So(len(linked.Payments), ShouldEqual, 1)
+					So(linked.Payments[0].Status, ShouldEqual, PaymentConfirmed)
+				})
+			})
+		})
+
+		Convey(`And I have a server which returns events with linked instalment schedules`, func() {
+			var requestQuery url.Values
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.Query()
+				w.Write([]byte(`{
+					"events": [
+						{
+							"id": "EV456",
+							"resource_type": "instalment_schedules",
+							"action": "created",
+							"links": { "instalment_schedule": "IS123" }
+						}
+					],
+					"linked": {
+						"instalment_schedules": [
+							{ "id": "IS123", "name": "Bike", "total_amount": 2500, "status": "active" }
+						]
+					},
+					"meta": { "limit": 50 }
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListEvents method including instalment schedules`, func() {
+				events, linked, err := client.ListEvents(&EventListOptions{
+					ResourceType: ResourceTypeInstalmentSchedules,
+					Include:      `instalment_schedule`,
+				})
+
+				Convey(`Then the include option will be sent`, func() {
+					So(requestQuery.Get(`include`), ShouldEqual, `instalment_schedule`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+					So(events[0].Links.InstalmentSchedule, ShouldEqual, `IS123`)
+				})
+
+				Convey(`Then the linked instalment schedules will be typed`, func() {
+					So(len(linked.InstalmentSchedules), ShouldEqual, 1)
+					So(linked.InstalmentSchedules[0].ID, ShouldEqual, `IS123`)
+					So(linked.InstalmentSchedules[0].TotalAmount, ShouldEqual, 2500)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,181 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	instalmentScheduleEndpoint = `/instalment_schedules`
+)
+
+// instalmentScheduleWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote
+// API
+type instalmentScheduleWrapper struct {
+	InstalmentSchedule *InstalmentSchedule `json:"instalment_schedules"`
+}
+
+// instalmentScheduleListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type instalmentScheduleListWrapper struct {
+	InstalmentSchedules []*InstalmentSchedule `json:"instalment_schedules"`
+}
+
+// instalmentScheduleCreation adds the instalments, either as a list of dates or as a plan, to the schedule being
+// created
+type instalmentScheduleCreation struct {
+	*InstalmentSchedule
+	Instalments interface{} `json:"instalments"`
+}
+
+// CreateInstalmentScheduleWithDates creates the schedule with the remote API, collecting each of the instalments on
+// its ChargeDate. The Currency, TotalAmount and Links.Mandate fields must be set, and a ValidationError is returned
+// without contacting the API when the schedule or an instalment is nil, or the instalment amounts do not add up to the
+// TotalAmount. On success the schedule is updated with the values returned by the API
+func (c *Client) CreateInstalmentScheduleWithDates(schedule *InstalmentSchedule, instalments []*Instalment) error {
+	return c.CreateInstalmentScheduleWithDatesWithContext(context.Background(), schedule, instalments)
+}
+
+// CreateInstalmentScheduleWithDatesWithContext is CreateInstalmentScheduleWithDates with a context, which can cancel
+// the request or set its deadline
+func (c *Client) CreateInstalmentScheduleWithDatesWithContext(ctx context.Context, schedule *InstalmentSchedule, instalments []*Instalment) error {
+	if schedule == nil {
+		return errMissingInstalmentSchedule()
+	}
+	total := 0
+	for i, instalment := range instalments {
+		if instalment == nil {
+			return newValidationError(&ErrorDetail{
+				Message:        `must be provided`,
+				Field:          `instalments`,
+				RequestPointer: fmt.Sprintf(`/instalment_schedules/instalments/%d`, i),
+			})
+		}
+		total += instalment.Amount
+	}
+	if err := validateInstalmentTotal(schedule, total); err != nil {
+		return err
+	}
+
+	return c.createInstalmentSchedule(ctx, schedule, instalments)
+}
+
+// CreateInstalmentScheduleWithSchedule creates the schedule with the remote API, collecting the amounts of the plan
+// at a regular interval. The Currency, TotalAmount and Links.Mandate fields must be set, and a ValidationError is
+// returned without contacting the API when the schedule or plan is nil, or the plan amounts do not add up to the
+// TotalAmount. On success the schedule is updated with the values returned by the API
+func (c *Client) CreateInstalmentScheduleWithSchedule(schedule *InstalmentSchedule, plan *InstalmentPlan) error {
+	return c.CreateInstalmentScheduleWithScheduleWithContext(context.Background(), schedule, plan)
+}
+
+// CreateInstalmentScheduleWithScheduleWithContext is CreateInstalmentScheduleWithSchedule with a context, which can
+// cancel the request or set its deadline
+func (c *Client) CreateInstalmentScheduleWithScheduleWithContext(ctx context.Context, schedule *InstalmentSchedule, plan *InstalmentPlan) error {
+	if schedule == nil {
+		return errMissingInstalmentSchedule()
+	}
+	if plan == nil {
+		return newValidationError(&ErrorDetail{
+			Message:        `must be provided`,
+			Field:          `instalments`,
+			RequestPointer: `/instalment_schedules/instalments`,
+		})
+	}
+	total := 0
+	for _, amount := range plan.Amounts {
+		total += amount
+	}
+	if err := validateInstalmentTotal(schedule, total); err != nil {
+		return err
+	}
+
+	return c.createInstalmentSchedule(ctx, schedule, plan)
+}
+
+func (c *Client) createInstalmentSchedule(ctx context.Context, schedule *InstalmentSchedule, instalments interface{}) error {
+	request := map[string]*instalmentScheduleCreation{`instalment_schedules`: {schedule, instalments}}
+	return c.create(ctx, instalmentScheduleEndpoint, request, &instalmentScheduleWrapper{schedule})
+}
+
+// errMissingInstalmentSchedule returns the ValidationError for a nil schedule
+func errMissingInstalmentSchedule() error {
+	return newValidationError(&ErrorDetail{
+		Message:        `must be provided`,
+		Field:          `instalment_schedules`,
+		RequestPointer: `/instalment_schedules`,
+	})
+}
+
+// validateInstalmentTotal returns a ValidationError when total does not match the TotalAmount of the schedule
+func validateInstalmentTotal(schedule *InstalmentSchedule, total int) error {
+	if total == schedule.TotalAmount {
+		return nil
+	}
+	return newValidationError(&ErrorDetail{
+		Message:        fmt.Sprintf(`must equal the sum of the instalment amounts (%d)`, total),
+		Field:          `total_amount`,
+		RequestPointer: `/instalment_schedules/total_amount`,
+	})
+}
+
+// GetInstalmentSchedule retrieves the details of the schedule with the given ID
+func (c *Client) GetInstalmentSchedule(id string) (*InstalmentSchedule, error) {
+	return c.GetInstalmentScheduleWithContext(context.Background(), id)
+}
+
+// GetInstalmentScheduleWithContext is GetInstalmentSchedule with a context, which can cancel the request or set its
+// deadline
+func (c *Client) GetInstalmentScheduleWithContext(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	wrapper := &instalmentScheduleWrapper{}
+	path := fmt.Sprintf(`%s/%s`, instalmentScheduleEndpoint, id)
+	if err := c.execute(ctx, http.MethodGet, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.InstalmentSchedule, nil
+}
+
+// ListInstalmentSchedules returns the schedules matching the supplied options. The options may be nil
+func (c *Client) ListInstalmentSchedules(options *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error) {
+	return c.ListInstalmentSchedulesWithContext(context.Background(), options)
+}
+
+// ListInstalmentSchedulesWithContext is ListInstalmentSchedules with a context, which can cancel the request or set its
+// deadline
+func (c *Client) ListInstalmentSchedulesWithContext(ctx context.Context, options *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error) {
+	wrapper := &instalmentScheduleListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(instalmentScheduleEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.InstalmentSchedules, nil
+}
+
+// UpdateInstalmentSchedule sends the Metadata of the schedule to the remote API, which is the only field that may be
+// updated. On success the schedule is updated with the values returned by the API
+func (c *Client) UpdateInstalmentSchedule(schedule *InstalmentSchedule) error {
+	return c.UpdateInstalmentScheduleWithContext(context.Background(), schedule)
+}
+
+// UpdateInstalmentScheduleWithContext is UpdateInstalmentSchedule with a context, which can cancel the request or set
+// its deadline
+func (c *Client) UpdateInstalmentScheduleWithContext(ctx context.Context, schedule *InstalmentSchedule) error {
+	request := map[string]*metadataUpdate{`instalment_schedules`: {schedule.Metadata}}
+	path := fmt.Sprintf(`%s/%s`, instalmentScheduleEndpoint, schedule.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &instalmentScheduleWrapper{schedule})
+}
+
+// CancelInstalmentSchedule immediately cancels the schedule with the given ID, along with any of its payments which
+// have not yet been submitted
+func (c *Client) CancelInstalmentSchedule(id string) (*InstalmentSchedule, error) {
+	return c.CancelInstalmentScheduleWithContext(context.Background(), id)
+}
+
+// CancelInstalmentScheduleWithContext is CancelInstalmentSchedule with a context, which can cancel the request or set
+// its deadline
+func (c *Client) CancelInstalmentScheduleWithContext(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	wrapper := &instalmentScheduleWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/cancel`, instalmentScheduleEndpoint, id)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.InstalmentSchedule, nil
+}
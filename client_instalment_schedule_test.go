@@ -0,0 +1,275 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const instalmentScheduleResponse = `{
+	"instalment_schedules": {
+		"id": "IS123",
+		"created_at": "2016-01-01T12:00:00.000Z",
+		"total_amount": 2500,
+		"currency": "GBP",
+		"status": "active",
+		"name": "Bike Invoice 271",
+		"metadata": {},
+		"payment_errors": {},
+		"links": {
+			"mandate": "MD123",
+			"customer": "CU123",
+			"payments": ["PM123", "PM456"]
+		}
+	}
+}`
+
+func TestClientCreateInstalmentScheduleWithDates(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			isCalled := false
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				isCalled = true
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(instalmentScheduleResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have instalments which add up to the total amount`, func() {
+				schedule := &InstalmentSchedule{
+					Name:        `Bike Invoice 271`,
+					Currency:    `GBP`,
+					TotalAmount: 2500,
+					Links:       &InstalmentScheduleLinks{Mandate: `MD123`},
+				}
+				instalments := []*Instalment{
+					{ChargeDate: `2019-08-20`, Amount: 1500},
+					{ChargeDate: `2019-09-03`, Amount: 1000},
+				}
+
+				Convey(`When I call the CreateInstalmentScheduleWithDates method`, func() {
+					err := client.CreateInstalmentScheduleWithDates(schedule, instalments)
+
+					Convey(`Then the URL will use the instalment schedules endpoint`, func() {
+						So(requestPath, ShouldEqual, instalmentScheduleEndpoint)
+					})
+
+					Convey(`Then the schedule and the dated instalments will be sent`, func() {
+						sent := requestBody[`instalment_schedules`]
+						So(sent[`total_amount`], ShouldEqual, 2500)
+						So(len(sent[`instalments`].([]interface{})), ShouldEqual, 2)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the linked payments will be populated`, func() {
+						So(schedule.ID, ShouldEqual, `IS123`)
+						So(schedule.Links.Payments, ShouldResemble, []string{`PM123`, `PM456`})
+					})
+				})
+			})
+
+			Convey(`And I have instalments which do not add up to the total amount`, func() {
+				schedule := &InstalmentSchedule{TotalAmount: 2500}
+				instalments := []*Instalment{{Amount: 1500}, {Amount: 500}}
+
+				Convey(`When I call the CreateInstalmentScheduleWithDates method`, func() {
+					err := client.CreateInstalmentScheduleWithDates(schedule, instalments)
+
+					Convey(`Then a validation error will be returned`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						gcErr := validationErr.Err
+						So(gcErr.Type, ShouldEqual, ValidationFailedType)
+						So(gcErr.Details[0].Field, ShouldEqual, `total_amount`)
+						So(gcErr.Details[0].RequestPointer, ShouldEqual, `/instalment_schedules/total_amount`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have a nil schedule`, func() {
+				Convey(`When I call the CreateInstalmentScheduleWithDates method`, func() {
+					err := client.CreateInstalmentScheduleWithDates(nil, []*Instalment{{Amount: 1500}})
+
+					Convey(`Then a validation error will be returned for the schedule`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/instalment_schedules`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have instalments which include a nil instalment`, func() {
+				schedule := &InstalmentSchedule{TotalAmount: 2500}
+				instalments := []*Instalment{{Amount: 1500}, nil}
+
+				Convey(`When I call the CreateInstalmentScheduleWithDates method`, func() {
+					err := client.CreateInstalmentScheduleWithDates(schedule, instalments)
+
+					Convey(`Then a validation error will be returned for the nil instalment`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].Field, ShouldEqual, `instalments`)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/instalment_schedules/instalments/1`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientCreateInstalmentScheduleWithSchedule(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			isCalled := false
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				isCalled = true
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(instalmentScheduleResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a plan whose amounts add up to the total amount`, func() {
+				schedule := &InstalmentSchedule{Currency: `GBP`, TotalAmount: 2500}
+				plan := &InstalmentPlan{
+					StartDate:    `2019-08-20`,
+					IntervalUnit: IntervalMonthly,
+					Interval:     1,
+					Amounts:      []int{1500, 1000},
+				}
+
+				Convey(`When I call the CreateInstalmentScheduleWithSchedule method`, func() {
+					err := client.CreateInstalmentScheduleWithSchedule(schedule, plan)
+
+					Convey(`Then the plan will be sent as the instalments`, func() {
+						instalments := requestBody[`instalment_schedules`][`instalments`].(map[string]interface{})
+						So(instalments[`interval_unit`], ShouldEqual, `monthly`)
+						So(instalments[`start_date`], ShouldEqual, `2019-08-20`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+				})
+			})
+
+			Convey(`And I have a plan whose amounts do not add up to the total amount`, func() {
+				schedule := &InstalmentSchedule{TotalAmount: 2500}
+				plan := &InstalmentPlan{IntervalUnit: IntervalMonthly, Interval: 1, Amounts: []int{1000, 1000}}
+
+				Convey(`When I call the CreateInstalmentScheduleWithSchedule method`, func() {
+					err := client.CreateInstalmentScheduleWithSchedule(schedule, plan)
+
+					Convey(`Then the error will not be nil`, func() {
+						So(err, ShouldNotBeNil)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have a nil schedule`, func() {
+				plan := &InstalmentPlan{IntervalUnit: IntervalMonthly, Interval: 1, Amounts: []int{1000}}
+
+				Convey(`When I call the CreateInstalmentScheduleWithSchedule method`, func() {
+					err := client.CreateInstalmentScheduleWithSchedule(nil, plan)
+
+					Convey(`Then a validation error will be returned for the schedule`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/instalment_schedules`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey(`And I have a nil plan`, func() {
+				schedule := &InstalmentSchedule{TotalAmount: 2500}
+
+				Convey(`When I call the CreateInstalmentScheduleWithSchedule method`, func() {
+					err := client.CreateInstalmentScheduleWithSchedule(schedule, nil)
+
+					Convey(`Then a validation error will be returned for the instalments`, func() {
+						validationErr, ok := err.(ValidationError)
+						So(ok, ShouldBeTrue)
+						So(validationErr.Err.Details[0].RequestPointer, ShouldEqual, `/instalment_schedules/instalments`)
+					})
+
+					Convey(`Then the API will not be called`, func() {
+						So(isCalled, ShouldBeFalse)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientCancelInstalmentSchedule(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				w.Write([]byte(instalmentScheduleResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CancelInstalmentSchedule method`, func() {
+				_, err := client.CancelInstalmentSchedule(`IS123`)
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/instalment_schedules/IS123/actions/cancel`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
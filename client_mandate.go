@@ -0,0 +1,103 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	mandateEndpoint = `/mandates`
+)
+
+// mandateWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type mandateWrapper struct {
+	Mandate *Mandate `json:"mandates"`
+}
+
+// mandateListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type mandateListWrapper struct {
+	Mandates []*Mandate `json:"mandates"`
+}
+
+// CreateMandate creates the mandate with the remote API. The Links.CustomerBankAccount field must be set to the bank
+// account the mandate is against. On success the mandate is updated with the values returned by the API
+func (c *Client) CreateMandate(mandate *Mandate) error {
+	return c.CreateMandateWithContext(context.Background(), mandate)
+}
+
+// CreateMandateWithContext is CreateMandate with a context, which can cancel the request or set its deadline
+func (c *Client) CreateMandateWithContext(ctx context.Context, mandate *Mandate) error {
+	wrapper := &mandateWrapper{mandate}
+	return c.create(ctx, mandateEndpoint, wrapper, wrapper)
+}
+
+// GetMandate retrieves the details of the mandate with the given ID
+func (c *Client) GetMandate(id string) (*Mandate, error) {
+	return c.GetMandateWithContext(context.Background(), id)
+}
+
+// GetMandateWithContext is GetMandate with a context, which can cancel the request or set its deadline
+func (c *Client) GetMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	wrapper := &mandateWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, mandateEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Mandate, nil
+}
+
+// ListMandates returns the mandates matching the supplied options. The options may be nil
+func (c *Client) ListMandates(options *MandateListOptions) ([]*Mandate, error) {
+	return c.ListMandatesWithContext(context.Background(), options)
+}
+
+// ListMandatesWithContext is ListMandates with a context, which can cancel the request or set its deadline
+func (c *Client) ListMandatesWithContext(ctx context.Context, options *MandateListOptions) ([]*Mandate, error) {
+	wrapper := &mandateListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(mandateEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Mandates, nil
+}
+
+// UpdateMandate sends the Metadata of the mandate to the remote API, which is the only field that may be updated. On
+// success the mandate is updated with the values returned by the API
+func (c *Client) UpdateMandate(mandate *Mandate) error {
+	return c.UpdateMandateWithContext(context.Background(), mandate)
+}
+
+// UpdateMandateWithContext is UpdateMandate with a context, which can cancel the request or set its deadline
+func (c *Client) UpdateMandateWithContext(ctx context.Context, mandate *Mandate) error {
+	request := map[string]*metadataUpdate{`mandates`: {mandate.Metadata}}
+	path := fmt.Sprintf(`%s/%s`, mandateEndpoint, mandate.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &mandateWrapper{mandate})
+}
+
+// CancelMandate immediately cancels the mandate with the given ID, along with any pending payments against it
+func (c *Client) CancelMandate(id string) (*Mandate, error) {
+	return c.CancelMandateWithContext(context.Background(), id)
+}
+
+// CancelMandateWithContext is CancelMandate with a context, which can cancel the request or set its deadline
+func (c *Client) CancelMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	return c.mandateAction(ctx, id, `cancel`)
+}
+
+// ReinstateMandate reinstates a cancelled or expired mandate with the given ID
+func (c *Client) ReinstateMandate(id string) (*Mandate, error) {
+	return c.ReinstateMandateWithContext(context.Background(), id)
+}
+
+// ReinstateMandateWithContext is ReinstateMandate with a context, which can cancel the request or set its deadline
+func (c *Client) ReinstateMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	return c.mandateAction(ctx, id, `reinstate`)
+}
+
+func (c *Client) mandateAction(ctx context.Context, id, action string) (*Mandate, error) {
+	wrapper := &mandateWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/%s`, mandateEndpoint, id, action)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Mandate, nil
+}
@@ -0,0 +1,117 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	mandateImportEndpoint      = `/mandate_imports`
+	mandateImportEntryEndpoint = `/mandate_import_entries`
+)
+
+// mandateImportWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type mandateImportWrapper struct {
+	MandateImport *MandateImport `json:"mandate_imports"`
+}
+
+// mandateImportEntryWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote
+// API
+type mandateImportEntryWrapper struct {
+	MandateImportEntry *MandateImportEntry `json:"mandate_import_entries"`
+}
+
+// mandateImportEntryListWrapper is a utility struct used to unwrap the JSON response of the entries list endpoint
+type mandateImportEntryListWrapper struct {
+	MandateImportEntries []*MandateImportEntry `json:"mandate_import_entries"`
+}
+
+// CreateMandateImport creates the import with the remote API. The Scheme field must be set. On success the import is
+// updated with the values returned by the API
+func (c *Client) CreateMandateImport(mandateImport *MandateImport) error {
+	return c.CreateMandateImportWithContext(context.Background(), mandateImport)
+}
+
+// CreateMandateImportWithContext is CreateMandateImport with a context, which can cancel the request or set its
+// deadline
+func (c *Client) CreateMandateImportWithContext(ctx context.Context, mandateImport *MandateImport) error {
+	wrapper := &mandateImportWrapper{mandateImport}
+	return c.create(ctx, mandateImportEndpoint, wrapper, wrapper)
+}
+
+// GetMandateImport retrieves the details of the import with the given ID
+func (c *Client) GetMandateImport(id string) (*MandateImport, error) {
+	return c.GetMandateImportWithContext(context.Background(), id)
+}
+
+// GetMandateImportWithContext is GetMandateImport with a context, which can cancel the request or set its deadline
+func (c *Client) GetMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	wrapper := &mandateImportWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, mandateImportEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImport, nil
+}
+
+// SubmitMandateImport submits the import with the given ID for review. No further entries may be added once it has
+// been submitted
+func (c *Client) SubmitMandateImport(id string) (*MandateImport, error) {
+	return c.SubmitMandateImportWithContext(context.Background(), id)
+}
+
+// SubmitMandateImportWithContext is SubmitMandateImport with a context, which can cancel the request or set its
+// deadline
+func (c *Client) SubmitMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	return c.mandateImportAction(ctx, id, `submit`)
+}
+
+// CancelMandateImport cancels the import with the given ID. Imports which have already been processed cannot be
+// cancelled
+func (c *Client) CancelMandateImport(id string) (*MandateImport, error) {
+	return c.CancelMandateImportWithContext(context.Background(), id)
+}
+
+// CancelMandateImportWithContext is CancelMandateImport with a context, which can cancel the request or set its
+// deadline
+func (c *Client) CancelMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	return c.mandateImportAction(ctx, id, `cancel`)
+}
+
+func (c *Client) mandateImportAction(ctx context.Context, id, action string) (*MandateImport, error) {
+	wrapper := &mandateImportWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/%s`, mandateImportEndpoint, id, action)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImport, nil
+}
+
+// AddMandateImportEntry adds the entry to an import which has not yet been submitted. The Links.MandateImport field
+// must be set. On success the entry is updated with the values returned by the API
+func (c *Client) AddMandateImportEntry(entry *MandateImportEntry) error {
+	return c.AddMandateImportEntryWithContext(context.Background(), entry)
+}
+
+// AddMandateImportEntryWithContext is AddMandateImportEntry with a context, which can cancel the request or set its
+// deadline
+func (c *Client) AddMandateImportEntryWithContext(ctx context.Context, entry *MandateImportEntry) error {
+	wrapper := &mandateImportEntryWrapper{entry}
+	return c.create(ctx, mandateImportEntryEndpoint, wrapper, wrapper)
+}
+
+// ListMandateImportEntries returns the entries of an import. The MandateImport field of the options must be set. Once
+// the import has been processed the entries link to the customers, bank accounts and mandates which were created
+func (c *Client) ListMandateImportEntries(options *MandateImportEntryListOptions) ([]*MandateImportEntry, error) {
+	return c.ListMandateImportEntriesWithContext(context.Background(), options)
+}
+
+// ListMandateImportEntriesWithContext is ListMandateImportEntries with a context, which can cancel the request or set
+// its deadline
+func (c *Client) ListMandateImportEntriesWithContext(ctx context.Context, options *MandateImportEntryListOptions) ([]*MandateImportEntry, error) {
+	wrapper := &mandateImportEntryListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(mandateImportEntryEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.MandateImportEntries, nil
+}
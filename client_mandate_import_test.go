@@ -0,0 +1,193 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const mandateImportResponse = `{
+	"mandate_imports": {
+		"id": "IM123",
+		"scheme": "bacs",
+		"status": "created",
+		"created_at": "2018-03-12T14:03:04.000Z",
+		"links": {
+			"creditor": "CR123"
+		}
+	}
+}`
+
+func TestClientMandateImportActions(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(mandateImportResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CreateMandateImport method`, func() {
+				mandateImport := &MandateImport{Scheme: `bacs`}
+				err := client.CreateMandateImport(mandateImport)
+
+				Convey(`Then the URL will use the mandate imports endpoint`, func() {
+					So(requestPath, ShouldEqual, mandateImportEndpoint)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the import will be populated from the response`, func() {
+					So(mandateImport.ID, ShouldEqual, `IM123`)
+					So(mandateImport.Status, ShouldEqual, MandateImportCreated)
+				})
+			})
+
+			Convey(`When I call the SubmitMandateImport method`, func() {
+				_, err := client.SubmitMandateImport(`IM123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the submit action`, func() {
+					So(requestPath, ShouldEqual, `/mandate_imports/IM123/actions/submit`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey(`When I call the CancelMandateImport method`, func() {
+				client.CancelMandateImport(`IM123`)
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/mandate_imports/IM123/actions/cancel`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientAddMandateImportEntry(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{
+					"mandate_import_entries": {
+						"record_identifier": "bank-file.xml/line-1",
+						"created_at": "2018-03-03T00:00:00Z",
+						"links": {
+							"mandate_import": "IM123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have an entry with a record identifier`, func() {
+				entry := &MandateImportEntry{
+					RecordIdentifier: `bank-file.xml/line-1`,
+					Customer:         &Customer{GivenName: `Frank`, FamilyName: `Osborne`},
+					BankAccount:      &CustomerBankAccount{AccountNumber: `55779911`, BranchCode: `200000`},
+					Amendment:        &MandateImportEntryAmendment{OriginalMandateReference: `REFNMANDATE`},
+					Links:            &MandateImportEntryLinks{MandateImport: `IM123`},
+				}
+
+				Convey(`When I call the AddMandateImportEntry method`, func() {
+					err := client.AddMandateImportEntry(entry)
+
+					Convey(`Then the URL will use the mandate import entries endpoint`, func() {
+						So(requestPath, ShouldEqual, mandateImportEntryEndpoint)
+					})
+
+					Convey(`Then the record identifier and import link will be sent`, func() {
+						sent := requestBody[`mandate_import_entries`]
+						So(sent[`record_identifier`], ShouldEqual, `bank-file.xml/line-1`)
+						So(sent[`links`].(map[string]interface{})[`mandate_import`], ShouldEqual, `IM123`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the entry will be populated from the response`, func() {
+						So(entry.CreatedAt, ShouldNotBeNil)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientListMandateImportEntries(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns processed entries`, func() {
+			var requestQuery string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.RawQuery
+				w.Write([]byte(`{
+					"mandate_import_entries": [
+						{
+							"record_identifier": "bank-file.xml/line-1",
+							"links": {
+								"mandate_import": "IM123",
+								"customer": "CU123",
+								"customer_bank_account": "BA123",
+								"mandate": "MD123"
+							}
+						}
+					],
+					"meta": { "limit": 50 }
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListMandateImportEntries method for an import`, func() {
+				entries, err := client.ListMandateImportEntries(&MandateImportEntryListOptions{MandateImport: `IM123`})
+
+				Convey(`Then the import will be sent as a query parameter`, func() {
+					So(requestQuery, ShouldEqual, `mandate_import=IM123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the entries will map back to the created mandates`, func() {
+					So(entries[0].RecordIdentifier, ShouldEqual, `bank-file.xml/line-1`)
+					So(entries[0].Links.Mandate, ShouldEqual, `MD123`)
+				})
+			})
+		})
+	})
+}
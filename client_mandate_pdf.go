@@ -0,0 +1,42 @@
+package gocardless
+
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	mandatePDFEndpoint = `/mandate_pdfs`
+)
+
+// mandatePDFRequestWrapper is a utility struct used to wrap the JSON request being passed to the remote API
+type mandatePDFRequestWrapper struct {
+	Request *MandatePDFRequest `json:"mandate_pdfs"`
+}
+
+// mandatePDFWrapper is a utility struct used to unwrap the JSON response of the remote API
+type mandatePDFWrapper struct {
+	MandatePDF *MandatePDF `json:"mandate_pdfs"`
+}
+
+// CreateMandatePDF generates a PDF of a mandate and returns a temporary link to it. The language is an ISO 639-1
+// code, using the same values as Customer.Language, and is sent as the Accept-Language header so that the PDF is
+// produced in that language. When the language is blank GoCardless will use English
+func (c *Client) CreateMandatePDF(request *MandatePDFRequest, language string) (*MandatePDF, error) {
+	return c.CreateMandatePDFWithContext(context.Background(), request, language)
+}
+
+// CreateMandatePDFWithContext is CreateMandatePDF with a context, which can cancel the request or set its deadline
+func (c *Client) CreateMandatePDFWithContext(ctx context.Context, request *MandatePDFRequest, language string) (*MandatePDF, error) {
+	header := http.Header{}
+	if language != `` {
+		header.Set(`Accept-Language`, language)
+	}
+
+	wrapper := &mandatePDFWrapper{}
+	body := &mandatePDFRequestWrapper{request}
+	if err := c.executeWithHeader(ctx, http.MethodPost, mandatePDFEndpoint, header, body, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.MandatePDF, nil
+}
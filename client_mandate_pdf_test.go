@@ -0,0 +1,100 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestClientCreateMandatePDF(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestHeader http.Header
+			var requestBody []byte
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				requestHeader = req.Header
+				requestBody, _ = ioutil.ReadAll(req.Body)
+				w.Write([]byte(`{
+					"mandate_pdfs": {
+						"url": "https://mandate-previews.gocardless.com/?token=abc",
+						"expires_at": "2018-03-12T14:33:04.000Z"
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I create a PDF for an existing mandate in French`, func() {
+				customer := &Customer{Language: `fr`}
+				request := &MandatePDFRequest{Links: &MandatePDFLinks{Mandate: `MD123`}}
+				pdf, err := client.CreateMandatePDF(request, customer.Language)
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the request will be a POST to the mandate PDFs endpoint`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+					So(requestPath, ShouldEqual, mandatePDFEndpoint)
+				})
+
+				Convey(`Then the Accept-Language header will be set`, func() {
+					So(requestHeader.Get(`Accept-Language`), ShouldEqual, `fr`)
+				})
+
+				Convey(`Then the default headers will still be sent`, func() {
+					So(requestHeader.Get(`Accept`), ShouldEqual, jsonMimeType)
+					So(requestHeader.Get(`GoCardless-Version`), ShouldEqual, APIVersion)
+				})
+
+				Convey(`Then the mandate link will be wrapped in the request body`, func() {
+					body := map[string]*MandatePDFRequest{}
+					So(json.Unmarshal(requestBody, &body), ShouldBeNil)
+					So(body[`mandate_pdfs`].Links.Mandate, ShouldEqual, `MD123`)
+				})
+
+				Convey(`Then the URL and expiry will be returned`, func() {
+					So(pdf.URL, ShouldEqual, `https://mandate-previews.gocardless.com/?token=abc`)
+					So(pdf.ExpiresAt, ShouldNotBeNil)
+					So(pdf.ExpiresAt.Hour(), ShouldEqual, 14)
+				})
+			})
+
+			Convey(`When I create a prefilled PDF without a language`, func() {
+				request := &MandatePDFRequest{
+					AccountHolderName: `Frank Osborne`,
+					AccountNumber:     `55779911`,
+					BranchCode:        `200000`,
+					CountryCode:       `GB`,
+				}
+				_, err := client.CreateMandatePDF(request, ``)
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the Accept-Language header will not be sent`, func() {
+					So(requestHeader.Get(`Accept-Language`), ShouldEqual, ``)
+				})
+
+				Convey(`Then the bank details will be sent in the request body`, func() {
+					body := map[string]*MandatePDFRequest{}
+					So(json.Unmarshal(requestBody, &body), ShouldBeNil)
+					So(body[`mandate_pdfs`].AccountNumber, ShouldEqual, `55779911`)
+					So(body[`mandate_pdfs`].Links, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,203 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const mandateResponse = `{
+	"mandates": {
+		"id": "MD123",
+		"created_at": "2014-05-08T17:01:06.000Z",
+		"reference": "REF-123",
+		"status": "pending_submission",
+		"scheme": "bacs",
+		"next_possible_charge_date": "2014-11-10",
+		"metadata": {
+			"contract": "ABCD1234"
+		},
+		"links": {
+			"customer_bank_account": "BA123",
+			"creditor": "CR123",
+			"customer": "CU123"
+		}
+	}
+}`
+
+func TestClientCreateMandate(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody map[string]*Mandate
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(mandateResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a mandate linked to a bank account`, func() {
+				mandate := &Mandate{
+					Scheme: `bacs`,
+					Links:  &MandateLinks{CustomerBankAccount: `BA123`},
+				}
+
+				Convey(`When I call the CreateMandate method`, func() {
+					err := client.CreateMandate(mandate)
+
+					Convey(`Then the request method will be POST`, func() {
+						So(requestMethod, ShouldEqual, http.MethodPost)
+					})
+
+					Convey(`Then the URL will use the mandates endpoint`, func() {
+						So(requestPath, ShouldEqual, mandateEndpoint)
+					})
+
+					Convey(`Then the bank account link will be sent`, func() {
+						So(requestBody[`mandates`].Links.CustomerBankAccount, ShouldEqual, `BA123`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the mandate will be populated from the response`, func() {
+						So(mandate.ID, ShouldEqual, `MD123`)
+						So(mandate.Status, ShouldEqual, MandatePendingSubmission)
+						So(mandate.NextPossibleChargeDate, ShouldEqual, `2014-11-10`)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientGetMandate(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				w.Write([]byte(mandateResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the GetMandate method`, func() {
+				mandate, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the URL will use the mandates endpoint and ID`, func() {
+					So(requestPath, ShouldEqual, fmt.Sprintf(`%s/%s`, mandateEndpoint, `MD123`))
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the links will be populated`, func() {
+					So(mandate.Links.Creditor, ShouldEqual, `CR123`)
+					So(mandate.Links.Customer, ShouldEqual, `CU123`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientListMandates(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestQuery string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.RawQuery
+				w.Write([]byte(`{"mandates": [{"id": "MD123", "status": "active"}], "meta": {"limit": 50}}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListMandates method with filters`, func() {
+				mandates, err := client.ListMandates(&MandateListOptions{Customer: `CU123`, Status: MandateActive})
+
+				Convey(`Then the filters will be sent as query parameters`, func() {
+					So(requestQuery, ShouldEqual, `customer=CU123&status=active`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the mandates will be returned`, func() {
+					So(len(mandates), ShouldEqual, 1)
+					So(mandates[0].Status, ShouldEqual, MandateActive)
+				})
+			})
+		})
+	})
+}
+
+func TestClientMandateActions(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(mandateResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CancelMandate method`, func() {
+				_, err := client.CancelMandate(`MD123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/mandates/MD123/actions/cancel`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey(`When I call the ReinstateMandate method`, func() {
+				_, err := client.ReinstateMandate(`MD123`)
+
+				Convey(`Then the URL will use the reinstate action`, func() {
+					So(requestPath, ShouldEqual, `/mandates/MD123/actions/reinstate`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,75 @@
+package gocardless
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ClientOption configures a Client created by NewClient
+type ClientOption func(*Client)
+
+// WithHTTPClient sets the http.Client used to send requests, for example to configure a proxy or connection pooling.
+// The http.Client is shared, so when WithTimeout has already been applied a copy of it is made with the timeout
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+		c.applyTimeout()
+	}
+}
+
+// WithBaseURL sends requests to url instead of the address of the environment, for example to use a local fake of
+// the GoCardless API. The environment is still used for its restrictions
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) {
+		c.RemoteURL = strings.TrimSuffix(url, `/`)
+	}
+}
+
+// WithAPIVersion requests version of the GoCardless API instead of the version given by the APIVersion constant
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		c.APIVersion = version
+	}
+}
+
+// WithUserAgent sends userAgent as the User-Agent header of each request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.UserAgent = userAgent
+	}
+}
+
+// WithTimeout limits the time taken by each request, including reading the response. It may be given before or after
+// WithHTTPClient. When an http.Client has been set a copy of it is made with the timeout, leaving the shared
+// http.Client unmodified
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.timeout = timeout
+		c.applyTimeout()
+	}
+}
+
+// WithRetryPolicy sets the RetryPolicy of the Client
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.RetryPolicy = policy
+	}
+}
+
+// WithRecoverIdempotentConflicts makes the create methods of the Client return the resource created by an earlier
+// request with the same Idempotency-Key instead of an idempotent_creation_conflict error. See
+// Client.RecoverIdempotentConflicts
+func WithRecoverIdempotentConflicts() ClientOption {
+	return func(c *Client) {
+		c.RecoverIdempotentConflicts = true
+	}
+}
+
+// WithRateLimiter sets the RateLimiter of the Client. The same RateLimiter should be given to every Client using the
+// access token
+func WithRateLimiter(limiter *RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.RateLimiter = limiter
+	}
+}
@@ -0,0 +1,142 @@
+package gocardless
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+func TestNewClientOptions(t *testing.T) {
+	Convey(`Given I have a server which records the headers of each request`, t, func() {
+		var requestHeader http.Header
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			requestHeader = req.Header
+			w.Write([]byte(`{"mandates": {"id": "MD123"}}`))
+		}))
+		defer srv.Close()
+
+		Convey(`When I create a client with options`, func() {
+			httpClient := &http.Client{}
+			api, err := NewClient(`abcdef`, SandboxEnvironment,
+				WithHTTPClient(httpClient),
+				WithBaseURL(srv.URL+`/`),
+				WithAPIVersion(`2020-01-01`),
+				WithUserAgent(`acme-billing/1.0`),
+				WithTimeout(5*time.Second),
+			)
+			So(err, ShouldBeNil)
+			client := api.(*Client)
+
+			Convey(`Then the base URL will replace the URL of the environment`, func() {
+				So(client.RemoteURL, ShouldEqual, srv.URL)
+				So(client.Environment, ShouldEqual, SandboxEnvironment)
+			})
+
+			Convey(`Then the timeout will be set on a copy of the http.Client`, func() {
+				So(client.HTTPClient.Timeout, ShouldEqual, 5*time.Second)
+				So(httpClient.Timeout, ShouldEqual, 0)
+			})
+
+			Convey(`And I make a request`, func() {
+				_, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the request will be sent to the base URL`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the API version will be sent`, func() {
+					So(requestHeader.Get(`GoCardless-Version`), ShouldEqual, `2020-01-01`)
+				})
+
+				Convey(`Then the user agent will be sent`, func() {
+					So(requestHeader.Get(`User-Agent`), ShouldEqual, `acme-billing/1.0`)
+				})
+			})
+		})
+
+		Convey(`When I create a client with a timeout given before the http.Client`, func() {
+			httpClient := &http.Client{Transport: &http.Transport{}}
+			api, err := NewClient(`abcdef`, SandboxEnvironment,
+				WithTimeout(5*time.Second),
+				WithHTTPClient(httpClient),
+			)
+			So(err, ShouldBeNil)
+			client := api.(*Client)
+
+			Convey(`Then the timeout will be set on a copy of the http.Client`, func() {
+				So(client.HTTPClient.Timeout, ShouldEqual, 5*time.Second)
+				So(client.HTTPClient.Transport, ShouldEqual, httpClient.Transport)
+				So(httpClient.Timeout, ShouldEqual, 0)
+			})
+		})
+
+		Convey(`When I create a client with a timeout but no http.Client`, func() {
+			api, _ := NewClient(`abcdef`, SandboxEnvironment, WithTimeout(5*time.Second))
+			client := api.(*Client)
+
+			Convey(`Then a new http.Client will be created with the timeout`, func() {
+				So(client.HTTPClient, ShouldNotEqual, http.DefaultClient)
+				So(client.HTTPClient.Timeout, ShouldEqual, 5*time.Second)
+				So(http.DefaultClient.Timeout, ShouldEqual, 0)
+			})
+		})
+
+		Convey(`When I apply a timeout directly to a client`, func() {
+			httpClient := &http.Client{}
+			client := &Client{HTTPClient: httpClient}
+			WithTimeout(5 * time.Second)(client)
+
+			Convey(`Then the timeout will be set on a copy of the http.Client`, func() {
+				So(client.HTTPClient.Timeout, ShouldEqual, 5*time.Second)
+				So(httpClient.Timeout, ShouldEqual, 0)
+			})
+
+			Convey(`And I then apply an http.Client directly to it`, func() {
+				other := &http.Client{}
+				WithHTTPClient(other)(client)
+
+				Convey(`Then the timeout will be set on a copy of that http.Client`, func() {
+					So(client.HTTPClient.Timeout, ShouldEqual, 5*time.Second)
+					So(other.Timeout, ShouldEqual, 0)
+				})
+			})
+		})
+
+		Convey(`When I create a client without options`, func() {
+			api, err := NewClient(`abcdef`, SandboxEnvironment)
+			So(err, ShouldBeNil)
+			client := api.(*Client)
+			client.RemoteURL = srv.URL
+
+			Convey(`And I make a request`, func() {
+				client.GetMandate(`MD123`)
+
+				Convey(`Then the default API version will be sent`, func() {
+					So(requestHeader.Get(`GoCardless-Version`), ShouldEqual, APIVersion)
+				})
+			})
+		})
+	})
+
+	Convey(`When I create a client with a retry policy and rate limiter`, t, func() {
+		policy := DefaultRetryPolicy()
+		limiter := NewRateLimiter(10)
+		api, _ := NewClient(`abcdef`, LiveEnvironment, WithRetryPolicy(policy), WithRateLimiter(limiter))
+
+		Convey(`Then they will be set on the client`, func() {
+			So(api.(*Client).RetryPolicy, ShouldEqual, policy)
+			So(api.(*Client).RateLimiter, ShouldEqual, limiter)
+		})
+	})
+
+	Convey(`When I create a client which recovers idempotent conflicts`, t, func() {
+		api, _ := NewClient(`abcdef`, SandboxEnvironment, WithRecoverIdempotentConflicts())
+
+		Convey(`Then RecoverIdempotentConflicts will be set on the client`, func() {
+			So(api.(*Client).RecoverIdempotentConflicts, ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,105 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	paymentEndpoint = `/payments`
+)
+
+// paymentWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type paymentWrapper struct {
+	Payment *Payment `json:"payments"`
+}
+
+// paymentListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type paymentListWrapper struct {
+	Payments []*Payment `json:"payments"`
+}
+
+// CreatePayment creates the payment with the remote API. The Amount, Currency and Links.Mandate fields must be set.
+// On success the payment is updated with the values returned by the API
+func (c *Client) CreatePayment(payment *Payment) error {
+	return c.CreatePaymentWithContext(context.Background(), payment)
+}
+
+// CreatePaymentWithContext is CreatePayment with a context, which can cancel the request or set its deadline
+func (c *Client) CreatePaymentWithContext(ctx context.Context, payment *Payment) error {
+	wrapper := &paymentWrapper{payment}
+	return c.create(ctx, paymentEndpoint, wrapper, wrapper)
+}
+
+// GetPayment retrieves the details of the payment with the given ID
+func (c *Client) GetPayment(id string) (*Payment, error) {
+	return c.GetPaymentWithContext(context.Background(), id)
+}
+
+// GetPaymentWithContext is GetPayment with a context, which can cancel the request or set its deadline
+func (c *Client) GetPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	wrapper := &paymentWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, paymentEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Payment, nil
+}
+
+// ListPayments returns the payments matching the supplied options. The options may be nil
+func (c *Client) ListPayments(options *PaymentListOptions) ([]*Payment, error) {
+	return c.ListPaymentsWithContext(context.Background(), options)
+}
+
+// ListPaymentsWithContext is ListPayments with a context, which can cancel the request or set its deadline
+func (c *Client) ListPaymentsWithContext(ctx context.Context, options *PaymentListOptions) ([]*Payment, error) {
+	wrapper := &paymentListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(paymentEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Payments, nil
+}
+
+// UpdatePayment sends the Metadata of the payment to the remote API. On success the payment is updated with the
+// values returned by the API
+func (c *Client) UpdatePayment(payment *Payment) error {
+	return c.UpdatePaymentWithContext(context.Background(), payment)
+}
+
+// UpdatePaymentWithContext is UpdatePayment with a context, which can cancel the request or set its deadline
+func (c *Client) UpdatePaymentWithContext(ctx context.Context, payment *Payment) error {
+	request := map[string]*metadataUpdate{`payments`: {payment.Metadata}}
+	path := fmt.Sprintf(`%s/%s`, paymentEndpoint, payment.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &paymentWrapper{payment})
+}
+
+// CancelPayment cancels the payment with the given ID. Only payments which are pending submission or pending customer
+// approval may be cancelled
+func (c *Client) CancelPayment(id string) (*Payment, error) {
+	return c.CancelPaymentWithContext(context.Background(), id)
+}
+
+// CancelPaymentWithContext is CancelPayment with a context, which can cancel the request or set its deadline
+func (c *Client) CancelPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	return c.paymentAction(ctx, id, `cancel`)
+}
+
+// RetryPayment retries the failed payment with the given ID. The payment will be resubmitted on the next available
+// charge date
+func (c *Client) RetryPayment(id string) (*Payment, error) {
+	return c.RetryPaymentWithContext(context.Background(), id)
+}
+
+// RetryPaymentWithContext is RetryPayment with a context, which can cancel the request or set its deadline
+func (c *Client) RetryPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	return c.paymentAction(ctx, id, `retry`)
+}
+
+func (c *Client) paymentAction(ctx context.Context, id, action string) (*Payment, error) {
+	wrapper := &paymentWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/%s`, paymentEndpoint, id, action)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Payment, nil
+}
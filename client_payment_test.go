@@ -0,0 +1,184 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+)
+
+const paymentResponse = `{
+	"payments": {
+		"id": "PM123",
+		"created_at": "2014-05-08T17:01:06.000Z",
+		"charge_date": "2014-05-21",
+		"amount": 100,
+		"description": null,
+		"currency": "GBP",
+		"status": "pending_submission",
+		"reference": "WINEBOX001",
+		"metadata": {
+			"order_dispatch_date": "2014-05-22"
+		},
+		"amount_refunded": 0,
+		"links": {
+			"mandate": "MD123",
+			"creditor": "CR123"
+		}
+	}
+}`
+
+func TestClientCreatePayment(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody map[string]*Payment
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(paymentResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a payment against a mandate`, func() {
+				payment := &Payment{
+					Amount:   100,
+					Currency: `GBP`,
+					AppFee:   10,
+					Links:    &PaymentLinks{Mandate: `MD123`},
+				}
+
+				Convey(`When I call the CreatePayment method`, func() {
+					err := client.CreatePayment(payment)
+
+					Convey(`Then the request method will be POST`, func() {
+						So(requestMethod, ShouldEqual, http.MethodPost)
+					})
+
+					Convey(`Then the URL will use the payments endpoint`, func() {
+						So(requestPath, ShouldEqual, paymentEndpoint)
+					})
+
+					Convey(`Then the amount, app fee and mandate will be sent`, func() {
+						So(requestBody[`payments`].Amount, ShouldEqual, 100)
+						So(requestBody[`payments`].AppFee, ShouldEqual, 10)
+						So(requestBody[`payments`].Links.Mandate, ShouldEqual, `MD123`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the payment will be populated from the response`, func() {
+						So(payment.ID, ShouldEqual, `PM123`)
+						So(payment.ChargeDate, ShouldEqual, `2014-05-21`)
+						So(payment.Status, ShouldEqual, PaymentPendingSubmission)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientListPayments(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestQuery url.Values
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.Query()
+				w.Write([]byte(`{"payments": [{"id": "PM123"}, {"id": "PM456"}], "meta": {"limit": 50}}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListPayments method with a charge date range`, func() {
+				payments, err := client.ListPayments(&PaymentListOptions{
+					Mandate:        `MD123`,
+					ChargeDateFrom: `2014-05-01`,
+					ChargeDateTo:   `2014-05-31`,
+				})
+
+				Convey(`Then the range will be sent as query parameters`, func() {
+					So(requestQuery.Get(`charge_date[gte]`), ShouldEqual, `2014-05-01`)
+					So(requestQuery.Get(`charge_date[lte]`), ShouldEqual, `2014-05-31`)
+					So(requestQuery.Get(`mandate`), ShouldEqual, `MD123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the payments will be returned`, func() {
+					So(len(payments), ShouldEqual, 2)
+				})
+			})
+		})
+	})
+}
+
+func TestClientPaymentActions(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(paymentResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CancelPayment method`, func() {
+				payment, err := client.CancelPayment(`PM123`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/payments/PM123/actions/cancel`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the payment will be returned`, func() {
+					So(payment.ID, ShouldEqual, `PM123`)
+				})
+			})
+
+			Convey(`When I call the RetryPayment method`, func() {
+				_, err := client.RetryPayment(`PM123`)
+
+				Convey(`Then the URL will use the retry action`, func() {
+					So(requestPath, ShouldEqual, `/payments/PM123/actions/retry`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,69 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	payoutEndpoint     = `/payouts`
+	payoutItemEndpoint = `/payout_items`
+)
+
+// payoutWrapper is a utility struct used to unwrap the JSON response of the remote API
+type payoutWrapper struct {
+	Payout *Payout `json:"payouts"`
+}
+
+// payoutListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type payoutListWrapper struct {
+	Payouts []*Payout `json:"payouts"`
+}
+
+// payoutItemListWrapper is a utility struct used to unwrap the JSON response of the payout items list endpoint
+type payoutItemListWrapper struct {
+	PayoutItems []*PayoutItem `json:"payout_items"`
+}
+
+// GetPayout retrieves the details of the payout with the given ID
+func (c *Client) GetPayout(id string) (*Payout, error) {
+	return c.GetPayoutWithContext(context.Background(), id)
+}
+
+// GetPayoutWithContext is GetPayout with a context, which can cancel the request or set its deadline
+func (c *Client) GetPayoutWithContext(ctx context.Context, id string) (*Payout, error) {
+	wrapper := &payoutWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, payoutEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Payout, nil
+}
+
+// ListPayouts returns the payouts matching the supplied options. The options may be nil
+func (c *Client) ListPayouts(options *PayoutListOptions) ([]*Payout, error) {
+	return c.ListPayoutsWithContext(context.Background(), options)
+}
+
+// ListPayoutsWithContext is ListPayouts with a context, which can cancel the request or set its deadline
+func (c *Client) ListPayoutsWithContext(ctx context.Context, options *PayoutListOptions) ([]*Payout, error) {
+	wrapper := &payoutListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(payoutEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Payouts, nil
+}
+
+// ListPayoutItems returns the items which make up a payout. The Payout field of the options must be set
+func (c *Client) ListPayoutItems(options *PayoutItemListOptions) ([]*PayoutItem, error) {
+	return c.ListPayoutItemsWithContext(context.Background(), options)
+}
+
+// ListPayoutItemsWithContext is ListPayoutItems with a context, which can cancel the request or set its deadline
+func (c *Client) ListPayoutItemsWithContext(ctx context.Context, options *PayoutItemListOptions) ([]*PayoutItem, error) {
+	wrapper := &payoutItemListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(payoutItemEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.PayoutItems, nil
+}
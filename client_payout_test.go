@@ -0,0 +1,126 @@
+package gocardless
+
+import (
+	"testing"
+
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestClientGetPayout(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				w.Write([]byte(`{
+					"payouts": {
+						"id": "PO123",
+						"amount": 1000,
+						"deducted_fees": 10,
+						"currency": "GBP",
+						"created_at": "2014-06-20T13:23:34.000Z",
+						"reference": "ref-1",
+						"arrival_date": "2014-06-27",
+						"status": "pending",
+						"links": {
+							"creditor_bank_account": "BA123",
+							"creditor": "CR123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the GetPayout method`, func() {
+				payout, err := client.GetPayout(`PO123`)
+
+				Convey(`Then the request method will be GET`, func() {
+					So(requestMethod, ShouldEqual, http.MethodGet)
+				})
+
+				Convey(`Then the URL will use the payouts endpoint and ID`, func() {
+					So(requestPath, ShouldEqual, fmt.Sprintf(`%s/%s`, payoutEndpoint, `PO123`))
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the payout will be populated`, func() {
+					So(payout.Amount, ShouldEqual, 1000)
+					So(payout.DeductedFees, ShouldEqual, 10)
+					So(payout.ArrivalDate, ShouldEqual, `2014-06-27`)
+					So(payout.Status, ShouldEqual, PayoutPending)
+					So(payout.Links.CreditorBankAccount, ShouldEqual, `BA123`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientListPayoutItems(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestQuery string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				requestQuery = req.URL.RawQuery
+				w.Write([]byte(`{
+					"payout_items": [
+						{
+							"amount": "10.00",
+							"type": "payment_paid_out",
+							"links": { "payment": "PM123", "mandate": "MD123" }
+						},
+						{
+							"amount": "-0.20",
+							"type": "gocardless_fee",
+							"taxes": [{ "amount": "0.04", "currency": "GBP", "tax_rate_id": "GB_VAT_1" }],
+							"links": { "payment": "PM123", "mandate": "MD123" }
+						}
+					],
+					"meta": { "limit": 50 }
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListPayoutItems method for a payout`, func() {
+				items, err := client.ListPayoutItems(&PayoutItemListOptions{Payout: `PO123`})
+
+				Convey(`Then the URL will use the payout items endpoint`, func() {
+					So(requestPath, ShouldEqual, payoutItemEndpoint)
+				})
+
+				Convey(`Then the payout will be sent as a query parameter`, func() {
+					So(requestQuery, ShouldEqual, `payout=PO123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the items will be typed`, func() {
+					So(len(items), ShouldEqual, 2)
+					So(items[0].Type, ShouldEqual, PayoutItemPaymentPaidOut)
+					So(items[0].Links.Payment, ShouldEqual, `PM123`)
+					So(items[1].Amount, ShouldEqual, `-0.20`)
+					So(items[1].Taxes[0].TaxRateID, ShouldEqual, `GB_VAT_1`)
+				})
+			})
+		})
+	})
+}
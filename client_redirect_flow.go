@@ -0,0 +1,67 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	redirectFlowEndpoint = `/redirect_flows`
+)
+
+// redirectFlowWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type redirectFlowWrapper struct {
+	RedirectFlow *RedirectFlow `json:"redirect_flows"`
+}
+
+// redirectFlowCompletion is the data sent to complete a redirect flow
+type redirectFlowCompletion struct {
+	SessionToken string `json:"session_token"`
+}
+
+// CreateRedirectFlow creates the redirect flow with the remote API. The SessionToken and SuccessRedirectURL fields
+// must be set. On success the flow is updated with the values returned by the API, and the customer should be sent
+// to its RedirectURL
+func (c *Client) CreateRedirectFlow(flow *RedirectFlow) error {
+	return c.CreateRedirectFlowWithContext(context.Background(), flow)
+}
+
+// CreateRedirectFlowWithContext is CreateRedirectFlow with a context, which can cancel the request or set its deadline
+func (c *Client) CreateRedirectFlowWithContext(ctx context.Context, flow *RedirectFlow) error {
+	wrapper := &redirectFlowWrapper{flow}
+	return c.create(ctx, redirectFlowEndpoint, wrapper, wrapper)
+}
+
+// GetRedirectFlow retrieves the details of the redirect flow with the given ID
+func (c *Client) GetRedirectFlow(id string) (*RedirectFlow, error) {
+	return c.GetRedirectFlowWithContext(context.Background(), id)
+}
+
+// GetRedirectFlowWithContext is GetRedirectFlow with a context, which can cancel the request or set its deadline
+func (c *Client) GetRedirectFlowWithContext(ctx context.Context, id string) (*RedirectFlow, error) {
+	wrapper := &redirectFlowWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, redirectFlowEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.RedirectFlow, nil
+}
+
+// CompleteRedirectFlow completes the redirect flow with the given ID once the customer has been returned to the
+// SuccessRedirectURL. The sessionToken must match the one the flow was created with. The returned flow links to the
+// newly created customer, customer bank account and mandate
+func (c *Client) CompleteRedirectFlow(id, sessionToken string) (*RedirectFlow, error) {
+	return c.CompleteRedirectFlowWithContext(context.Background(), id, sessionToken)
+}
+
+// CompleteRedirectFlowWithContext is CompleteRedirectFlow with a context, which can cancel the request or set its
+// deadline
+func (c *Client) CompleteRedirectFlowWithContext(ctx context.Context, id, sessionToken string) (*RedirectFlow, error) {
+	wrapper := &redirectFlowWrapper{}
+	request := &actionRequest{&redirectFlowCompletion{sessionToken}}
+	path := fmt.Sprintf(`%s/%s/actions/complete`, redirectFlowEndpoint, id)
+	if err := c.execute(ctx, http.MethodPost, path, request, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.RedirectFlow, nil
+}
@@ -0,0 +1,135 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestClientCreateRedirectFlow(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]*RedirectFlow
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{
+					"redirect_flows": {
+						"id": "RE123",
+						"description": "Wine boxes",
+						"session_token": "SESS_wSs0uGYMISxzqOBq",
+						"scheme": null,
+						"success_redirect_url": "https://example.com/pay/confirm",
+						"redirect_url": "https://pay.gocardless.com/flow/RE123",
+						"created_at": "2014-10-22T13:10:06.000Z",
+						"links": {
+							"creditor": "CR123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a redirect flow with a prefilled customer`, func() {
+				flow := &RedirectFlow{
+					Description:        `Wine boxes`,
+					SessionToken:       `SESS_wSs0uGYMISxzqOBq`,
+					SuccessRedirectURL: `https://example.com/pay/confirm`,
+					PrefilledCustomer:  &Customer{GivenName: `Frank`, FamilyName: `Osborne`},
+				}
+
+				Convey(`When I call the CreateRedirectFlow method`, func() {
+					err := client.CreateRedirectFlow(flow)
+
+					Convey(`Then the URL will use the redirect flows endpoint`, func() {
+						So(requestPath, ShouldEqual, redirectFlowEndpoint)
+					})
+
+					Convey(`Then the prefilled customer will be sent`, func() {
+						So(requestBody[`redirect_flows`].PrefilledCustomer.GivenName, ShouldEqual, `Frank`)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the redirect URL will be populated`, func() {
+						So(flow.ID, ShouldEqual, `RE123`)
+						So(flow.RedirectURL, ShouldEqual, `https://pay.gocardless.com/flow/RE123`)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientCompleteRedirectFlow(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+
+				w.Write([]byte(`{
+					"redirect_flows": {
+						"id": "RE123",
+						"session_token": "SESS_wSs0uGYMISxzqOBq",
+						"links": {
+							"creditor": "CR123",
+							"mandate": "MD123",
+							"customer": "CU123",
+							"customer_bank_account": "BA123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CompleteRedirectFlow method`, func() {
+				flow, err := client.CompleteRedirectFlow(`RE123`, `SESS_wSs0uGYMISxzqOBq`)
+
+				Convey(`Then the request method will be POST`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPost)
+				})
+
+				Convey(`Then the URL will use the complete action`, func() {
+					So(requestPath, ShouldEqual, `/redirect_flows/RE123/actions/complete`)
+				})
+
+				Convey(`Then the session token will be sent`, func() {
+					So(requestBody, ShouldEqual, `{"data":{"session_token":"SESS_wSs0uGYMISxzqOBq"}}`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the created mandate and customer will be linked`, func() {
+					So(flow.Links.Mandate, ShouldEqual, `MD123`)
+					So(flow.Links.Customer, ShouldEqual, `CU123`)
+				})
+			})
+		})
+	})
+}
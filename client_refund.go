@@ -0,0 +1,75 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	refundEndpoint = `/refunds`
+)
+
+// refundWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type refundWrapper struct {
+	Refund *Refund `json:"refunds"`
+}
+
+// refundListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type refundListWrapper struct {
+	Refunds []*Refund `json:"refunds"`
+}
+
+// CreateRefund creates the refund with the remote API. The Amount, TotalAmountConfirmation and Links.Payment fields
+// must be set. A RefundExceedsPaymentError or TotalAmountConfirmationInvalidError is returned when the API rejects
+// the amounts. On success the refund is updated with the values returned by the API
+func (c *Client) CreateRefund(refund *Refund) error {
+	return c.CreateRefundWithContext(context.Background(), refund)
+}
+
+// CreateRefundWithContext is CreateRefund with a context, which can cancel the request or set its deadline
+func (c *Client) CreateRefundWithContext(ctx context.Context, refund *Refund) error {
+	wrapper := &refundWrapper{refund}
+	return c.create(ctx, refundEndpoint, wrapper, wrapper)
+}
+
+// GetRefund retrieves the details of the refund with the given ID
+func (c *Client) GetRefund(id string) (*Refund, error) {
+	return c.GetRefundWithContext(context.Background(), id)
+}
+
+// GetRefundWithContext is GetRefund with a context, which can cancel the request or set its deadline
+func (c *Client) GetRefundWithContext(ctx context.Context, id string) (*Refund, error) {
+	wrapper := &refundWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, refundEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Refund, nil
+}
+
+// ListRefunds returns the refunds matching the supplied options. The options may be nil
+func (c *Client) ListRefunds(options *RefundListOptions) ([]*Refund, error) {
+	return c.ListRefundsWithContext(context.Background(), options)
+}
+
+// ListRefundsWithContext is ListRefunds with a context, which can cancel the request or set its deadline
+func (c *Client) ListRefundsWithContext(ctx context.Context, options *RefundListOptions) ([]*Refund, error) {
+	wrapper := &refundListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(refundEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Refunds, nil
+}
+
+// UpdateRefund sends the Metadata of the refund to the remote API, which is the only field that may be updated. On
+// success the refund is updated with the values returned by the API
+func (c *Client) UpdateRefund(refund *Refund) error {
+	return c.UpdateRefundWithContext(context.Background(), refund)
+}
+
+// UpdateRefundWithContext is UpdateRefund with a context, which can cancel the request or set its deadline
+func (c *Client) UpdateRefundWithContext(ctx context.Context, refund *Refund) error {
+	request := map[string]*metadataUpdate{`refunds`: {refund.Metadata}}
+	path := fmt.Sprintf(`%s/%s`, refundEndpoint, refund.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &refundWrapper{refund})
+}
@@ -0,0 +1,178 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestClientCreateRefund(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+			var requestBody map[string]map[string]interface{}
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{
+					"refunds": {
+						"id": "RF123",
+						"created_at": "2014-05-08T17:01:06.000Z",
+						"amount": 100,
+						"currency": "GBP",
+						"reference": "partial refund",
+						"status": "created",
+						"metadata": {},
+						"links": {
+							"payment": "PM123"
+						}
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a refund`, func() {
+				refund := &Refund{
+					Amount:                  100,
+					TotalAmountConfirmation: 150,
+					Links:                   &RefundLinks{Payment: `PM123`},
+				}
+
+				Convey(`When I call the CreateRefund method`, func() {
+					err := client.CreateRefund(refund)
+
+					Convey(`Then the URL will use the refunds endpoint`, func() {
+						So(requestPath, ShouldEqual, refundEndpoint)
+					})
+
+					Convey(`Then the total amount confirmation will be sent`, func() {
+						So(requestBody[`refunds`][`total_amount_confirmation`], ShouldEqual, 150)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the refund will be populated from the response`, func() {
+						So(refund.ID, ShouldEqual, `RF123`)
+						So(refund.Status, ShouldEqual, RefundCreated)
+					})
+				})
+			})
+		})
+
+		Convey(`And I have a server which rejects the refund amount`, func() {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{
+					"error": {
+						"message": "Refund amount exceeds the amount available to refund",
+						"type": "invalid_state",
+						"code": 422,
+						"request_id": "dd50eaaf-8213-48fe-90d6-5466872efbc4",
+						"errors": [{
+							"reason": "refund_exceeds_payment",
+							"message": "Refund amount exceeds the amount available to refund"
+						}]
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CreateRefund method`, func() {
+				err := client.CreateRefund(&Refund{Amount: 1000, TotalAmountConfirmation: 1000})
+
+				Convey(`Then the error will be a RefundExceedsPaymentError`, func() {
+					var refundErr RefundExceedsPaymentError
+					So(errors.As(err, &refundErr), ShouldBeTrue)
+					So(refundErr.Err.RequestID, ShouldEqual, `dd50eaaf-8213-48fe-90d6-5466872efbc4`)
+				})
+
+				Convey(`Then the error message will be that of the underlying GoCardless error`, func() {
+					So(err.Error(), ShouldEqual, err.(RefundExceedsPaymentError).Err.Error())
+					So(err.Error(), ShouldContainSubstring, `Refund amount exceeds the amount available to refund`)
+					So(err.Error(), ShouldContainSubstring, `dd50eaaf-8213-48fe-90d6-5466872efbc4`)
+				})
+			})
+		})
+
+		Convey(`And I have a server which rejects the total amount confirmation`, func() {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte(`{
+					"error": {
+						"message": "Total amount confirmation does not match",
+						"type": "invalid_state",
+						"code": 422,
+						"errors": [{
+							"reason": "total_amount_confirmation_invalid",
+							"message": "Total amount confirmation does not match"
+						}]
+					}
+				}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the CreateRefund method`, func() {
+				err := client.CreateRefund(&Refund{Amount: 100, TotalAmountConfirmation: 100})
+
+				Convey(`Then the error will be a TotalAmountConfirmationInvalidError`, func() {
+					var confirmationErr TotalAmountConfirmationInvalidError
+					So(errors.As(err, &confirmationErr), ShouldBeTrue)
+				})
+
+				Convey(`Then the error message will be that of the underlying GoCardless error`, func() {
+					So(err.Error(), ShouldEqual, err.(TotalAmountConfirmationInvalidError).Err.Error())
+					So(err.Error(), ShouldContainSubstring, `Total amount confirmation does not match`)
+				})
+			})
+		})
+	})
+}
+
+func TestClientListRefunds(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestQuery string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestQuery = req.URL.RawQuery
+				w.Write([]byte(`{"refunds": [{"id": "RF123"}], "meta": {"limit": 50}}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the ListRefunds method with a payment filter`, func() {
+				refunds, err := client.ListRefunds(&RefundListOptions{Payment: `PM123`})
+
+				Convey(`Then the payment will be sent as a query parameter`, func() {
+					So(requestQuery, ShouldEqual, `payment=PM123`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the refunds will be returned`, func() {
+					So(len(refunds), ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,129 @@
+package gocardless
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	subscriptionEndpoint = `/subscriptions`
+)
+
+// subscriptionWrapper is a utility struct used to wrap and unwrap the JSON request being passed to the remote API
+type subscriptionWrapper struct {
+	Subscription *Subscription `json:"subscriptions"`
+}
+
+// subscriptionListWrapper is a utility struct used to unwrap the JSON response of the list endpoint
+type subscriptionListWrapper struct {
+	Subscriptions []*Subscription `json:"subscriptions"`
+}
+
+// subscriptionUpdate holds the fields of a Subscription which may be changed after creation
+type subscriptionUpdate struct {
+	Amount           int               `json:"amount,omitempty"`
+	AppFee           int               `json:"app_fee,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	Name             string            `json:"name,omitempty"`
+	PaymentReference string            `json:"payment_reference,omitempty"`
+}
+
+// CreateSubscription creates the subscription with the remote API. The Amount, Currency, IntervalUnit and
+// Links.Mandate fields must be set. On success the subscription is updated with the values returned by the API
+func (c *Client) CreateSubscription(subscription *Subscription) error {
+	return c.CreateSubscriptionWithContext(context.Background(), subscription)
+}
+
+// CreateSubscriptionWithContext is CreateSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) CreateSubscriptionWithContext(ctx context.Context, subscription *Subscription) error {
+	wrapper := &subscriptionWrapper{subscription}
+	return c.create(ctx, subscriptionEndpoint, wrapper, wrapper)
+}
+
+// GetSubscription retrieves the details of the subscription with the given ID
+func (c *Client) GetSubscription(id string) (*Subscription, error) {
+	return c.GetSubscriptionWithContext(context.Background(), id)
+}
+
+// GetSubscriptionWithContext is GetSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) GetSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	wrapper := &subscriptionWrapper{}
+	if err := c.execute(ctx, http.MethodGet, fmt.Sprintf(`%s/%s`, subscriptionEndpoint, id), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Subscription, nil
+}
+
+// ListSubscriptions returns the subscriptions matching the supplied options. The options may be nil
+func (c *Client) ListSubscriptions(options *SubscriptionListOptions) ([]*Subscription, error) {
+	return c.ListSubscriptionsWithContext(context.Background(), options)
+}
+
+// ListSubscriptionsWithContext is ListSubscriptions with a context, which can cancel the request or set its deadline
+func (c *Client) ListSubscriptionsWithContext(ctx context.Context, options *SubscriptionListOptions) ([]*Subscription, error) {
+	wrapper := &subscriptionListWrapper{}
+	if err := c.execute(ctx, http.MethodGet, withQuery(subscriptionEndpoint, options), nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Subscriptions, nil
+}
+
+// UpdateSubscription sends the Amount, AppFee, Metadata, Name and PaymentReference of the subscription to the remote
+// API. On success the subscription is updated with the values returned by the API
+func (c *Client) UpdateSubscription(subscription *Subscription) error {
+	return c.UpdateSubscriptionWithContext(context.Background(), subscription)
+}
+
+// UpdateSubscriptionWithContext is UpdateSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) UpdateSubscriptionWithContext(ctx context.Context, subscription *Subscription) error {
+	request := map[string]*subscriptionUpdate{`subscriptions`: {
+		Amount:           subscription.Amount,
+		AppFee:           subscription.AppFee,
+		Metadata:         subscription.Metadata,
+		Name:             subscription.Name,
+		PaymentReference: subscription.PaymentReference,
+	}}
+	path := fmt.Sprintf(`%s/%s`, subscriptionEndpoint, subscription.ID)
+	return c.execute(ctx, http.MethodPut, path, request, &subscriptionWrapper{subscription})
+}
+
+// PauseSubscription pauses the subscription with the given ID indefinitely. No payments will be created until it is
+// resumed
+func (c *Client) PauseSubscription(id string) (*Subscription, error) {
+	return c.PauseSubscriptionWithContext(context.Background(), id)
+}
+
+// PauseSubscriptionWithContext is PauseSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) PauseSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	return c.subscriptionAction(ctx, id, `pause`)
+}
+
+// ResumeSubscription resumes the paused subscription with the given ID
+func (c *Client) ResumeSubscription(id string) (*Subscription, error) {
+	return c.ResumeSubscriptionWithContext(context.Background(), id)
+}
+
+// ResumeSubscriptionWithContext is ResumeSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) ResumeSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	return c.subscriptionAction(ctx, id, `resume`)
+}
+
+// CancelSubscription immediately cancels the subscription with the given ID. This cannot be undone
+func (c *Client) CancelSubscription(id string) (*Subscription, error) {
+	return c.CancelSubscriptionWithContext(context.Background(), id)
+}
+
+// CancelSubscriptionWithContext is CancelSubscription with a context, which can cancel the request or set its deadline
+func (c *Client) CancelSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	return c.subscriptionAction(ctx, id, `cancel`)
+}
+
+func (c *Client) subscriptionAction(ctx context.Context, id, action string) (*Subscription, error) {
+	wrapper := &subscriptionWrapper{}
+	path := fmt.Sprintf(`%s/%s/actions/%s`, subscriptionEndpoint, id, action)
+	if err := c.execute(ctx, http.MethodPost, path, nil, wrapper); err != nil {
+		return nil, err
+	}
+	return wrapper.Subscription, nil
+}
@@ -0,0 +1,183 @@
+package gocardless
+
+import (
+	"testing"
+
+	"encoding/json"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+)
+
+const subscriptionResponse = `{
+	"subscriptions": {
+		"id": "SB123",
+		"created_at": "2014-10-20T17:01:06.000Z",
+		"amount": 2500,
+		"currency": "GBP",
+		"status": "active",
+		"name": "Monthly Magazine",
+		"start_date": "2014-11-03",
+		"end_date": null,
+		"interval": 1,
+		"interval_unit": "monthly",
+		"day_of_month": 1,
+		"month": null,
+		"payment_reference": null,
+		"upcoming_payments": [
+			{ "charge_date": "2014-11-03", "amount": 2500 },
+			{ "charge_date": "2014-12-01", "amount": 2500 }
+		],
+		"metadata": {
+			"order_no": "ABCD1234"
+		},
+		"links": {
+			"mandate": "MA123"
+		}
+	}
+}`
+
+func TestClientCreateSubscription(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestPath string
+			var requestBody map[string]*Subscription
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				requestPath = req.URL.Path
+				body, _ := ioutil.ReadAll(req.Body)
+				json.Unmarshal(body, &requestBody)
+
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(subscriptionResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`And I have a monthly subscription`, func() {
+				subscription := &Subscription{
+					Amount:       2500,
+					Currency:     `GBP`,
+					Name:         `Monthly Magazine`,
+					IntervalUnit: IntervalMonthly,
+					DayOfMonth:   1,
+					Links:        &SubscriptionLinks{Mandate: `MA123`},
+				}
+
+				Convey(`When I call the CreateSubscription method`, func() {
+					err := client.CreateSubscription(subscription)
+
+					Convey(`Then the request method will be POST`, func() {
+						So(requestMethod, ShouldEqual, http.MethodPost)
+					})
+
+					Convey(`Then the URL will use the subscriptions endpoint`, func() {
+						So(requestPath, ShouldEqual, subscriptionEndpoint)
+					})
+
+					Convey(`Then the schedule will be sent`, func() {
+						So(requestBody[`subscriptions`].IntervalUnit, ShouldEqual, IntervalMonthly)
+						So(requestBody[`subscriptions`].DayOfMonth, ShouldEqual, 1)
+					})
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the upcoming payments will be decoded`, func() {
+						So(len(subscription.UpcomingPayments), ShouldEqual, 2)
+						So(subscription.UpcomingPayments[1].ChargeDate, ShouldEqual, `2014-12-01`)
+						So(subscription.UpcomingPayments[1].Amount, ShouldEqual, 2500)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestClientUpdateSubscription(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestMethod string
+			var requestBody string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestMethod = req.Method
+				body, _ := ioutil.ReadAll(req.Body)
+				requestBody = string(body)
+				w.Write([]byte(subscriptionResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the UpdateSubscription method`, func() {
+				subscription := &Subscription{ID: `SB123`, Name: `New name`, Status: SubscriptionActive}
+				err := client.UpdateSubscription(subscription)
+
+				Convey(`Then the request method will be PUT`, func() {
+					So(requestMethod, ShouldEqual, http.MethodPut)
+				})
+
+				Convey(`Then only the updatable fields will be sent`, func() {
+					So(requestBody, ShouldEqual, `{"subscriptions":{"name":"New name"}}`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
+
+func TestClientSubscriptionActions(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which returns a valid response`, func() {
+			var requestPath string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requestPath = req.URL.Path
+				w.Write([]byte(subscriptionResponse))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call the PauseSubscription method`, func() {
+				_, err := client.PauseSubscription(`SB123`)
+
+				Convey(`Then the URL will use the pause action`, func() {
+					So(requestPath, ShouldEqual, `/subscriptions/SB123/actions/pause`)
+				})
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey(`When I call the ResumeSubscription method`, func() {
+				client.ResumeSubscription(`SB123`)
+
+				Convey(`Then the URL will use the resume action`, func() {
+					So(requestPath, ShouldEqual, `/subscriptions/SB123/actions/resume`)
+				})
+			})
+
+			Convey(`When I call the CancelSubscription method`, func() {
+				client.CancelSubscription(`SB123`)
+
+				Convey(`Then the URL will use the cancel action`, func() {
+					So(requestPath, ShouldEqual, `/subscriptions/SB123/actions/cancel`)
+				})
+			})
+		})
+	})
+}
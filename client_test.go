@@ -3,10 +3,13 @@ package gocardless
 import (
 	"testing"
 
+	"context"
+	"errors"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"net/http"
 	"net/http/httptest"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -27,6 +30,10 @@ func TestNewClient(t *testing.T) {
 					So(client.(*Client).RemoteURL, ShouldEqual, BaseSandboxURL)
 				})
 
+				Convey(`Then the Environment field in the underlying type will be set`, func() {
+					So(client.(*Client).Environment, ShouldEqual, SandboxEnvironment)
+				})
+
 				Convey(`Then the returned error will be nil`, func() {
 					So(err, ShouldBeNil)
 				})
@@ -147,7 +154,7 @@ func TestNewRequest(t *testing.T) {
 				method := http.MethodPost
 
 				Convey(`When I call newRequest`, func() {
-					req, err := client.newRequest(path, method, nil)
+					req, err := client.newRequest(context.Background(), path, method, nil)
 					if err != nil {
 						panic(err)
 					}
@@ -176,7 +183,7 @@ func TestNewRequest(t *testing.T) {
 				method := http.MethodPatch
 
 				Convey(`When I call newRequest`, func() {
-					req, err := client.newRequest(path, method, nil)
+					req, err := client.newRequest(context.Background(), path, method, nil)
 
 					Convey(`Then the request will be nil`, func() {
 						So(req, ShouldBeNil)
@@ -203,7 +210,7 @@ func TestClientDo(t *testing.T) {
 			client.RemoteURL = srv.URL
 
 			Convey(`And I have a request`, func() {
-				req, err := client.newRequest(`/`, http.MethodGet, nil)
+				req, err := client.newRequest(context.Background(), `/`, http.MethodGet, nil)
 				if err != nil {
 					panic(err)
 				}
@@ -231,7 +238,7 @@ func TestClientDo(t *testing.T) {
 			client.RemoteURL = srv.URL
 
 			Convey(`And I have a request`, func() {
-				req, err := client.newRequest(`/`, http.MethodGet, nil)
+				req, err := client.newRequest(context.Background(), `/`, http.MethodGet, nil)
 				if err != nil {
 					panic(err)
 				}
@@ -253,3 +260,42 @@ func TestClientDo(t *testing.T) {
 		})
 	})
 }
+
+func TestClientWithContext(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And a server that does not respond in time`, func() {
+			release := make(chan struct{})
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				select {
+				case <-release:
+				case <-req.Context().Done():
+				}
+			}))
+			defer srv.Close()
+			defer close(release)
+			client.RemoteURL = srv.URL
+
+			Convey(`When I call a method with a context which has a deadline`, func() {
+				ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+				defer cancel()
+				_, err := client.GetMandateWithContext(ctx, `MD123`)
+
+				Convey(`Then the deadline error will be returned`, func() {
+					So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+				})
+			})
+
+			Convey(`When I call a method with a context which has been cancelled`, func() {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				_, err := client.GetCustomerWithContext(ctx, `CU123`)
+
+				Convey(`Then the cancellation error will be returned`, func() {
+					So(errors.Is(err, context.Canceled), ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
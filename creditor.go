@@ -0,0 +1,63 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// Creditor is the person or company collecting payments. Unless your account has been approved as a whitelabel
+// partner you will only have a single creditor
+type Creditor struct {
+	// ID is a unique identifier, beginning with “CR”.
+	ID string `json:"id,omitempty"`
+	// AddressLine1 is the first line of the creditor’s address.
+	AddressLine1 string `json:"address_line1,omitempty"`
+	// AddressLine2 is the second line of the creditor’s address.
+	AddressLine2 string `json:"address_line2,omitempty"`
+	// AddressLine3 is the third line of the creditor’s address.
+	AddressLine3 string `json:"address_line3,omitempty"`
+	// City is the city of the creditor’s address.
+	City string `json:"city,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code.
+	CountryCode string `json:"country_code,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the creditor was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// LogoURL is the URL of the creditor’s logo, as returned by the API.
+	LogoURL string `json:"logo_url,omitempty"`
+	// Name is the creditor’s name.
+	Name string `json:"name,omitempty"`
+	// PostalCode is the creditor’s postal code.
+	PostalCode string `json:"postal_code,omitempty"`
+	// Region is the creditor’s address region, county or department.
+	Region string `json:"region,omitempty"`
+	// SchemeIdentifiers is the creditor’s identifier and contact details for each scheme, as returned by the API.
+	SchemeIdentifiers []*Scheme `json:"scheme_identifiers,omitempty"`
+	// VerificationStatus is the state of the creditor’s verification, as returned by the API.
+	VerificationStatus string `json:"verification_status,omitempty"`
+	// Links holds the IDs of the resources the creditor is associated with
+	Links *CreditorLinks `json:"links,omitempty"`
+}
+
+// CreditorLinks holds the IDs of the default payout accounts of a Creditor, by currency
+type CreditorLinks struct {
+	// DefaultAUDPayoutAccount is the ID of the bank account which receives AUD payouts.
+	DefaultAUDPayoutAccount string `json:"default_aud_payout_account,omitempty"`
+	// DefaultCADPayoutAccount is the ID of the bank account which receives CAD payouts.
+	DefaultCADPayoutAccount string `json:"default_cad_payout_account,omitempty"`
+	// DefaultDKKPayoutAccount is the ID of the bank account which receives DKK payouts.
+	DefaultDKKPayoutAccount string `json:"default_dkk_payout_account,omitempty"`
+	// DefaultEURPayoutAccount is the ID of the bank account which receives EUR payouts.
+	DefaultEURPayoutAccount string `json:"default_eur_payout_account,omitempty"`
+	// DefaultGBPPayoutAccount is the ID of the bank account which receives GBP payouts.
+	DefaultGBPPayoutAccount string `json:"default_gbp_payout_account,omitempty"`
+	// DefaultNZDPayoutAccount is the ID of the bank account which receives NZD payouts.
+	DefaultNZDPayoutAccount string `json:"default_nzd_payout_account,omitempty"`
+	// DefaultSEKPayoutAccount is the ID of the bank account which receives SEK payouts.
+	DefaultSEKPayoutAccount string `json:"default_sek_payout_account,omitempty"`
+	// DefaultUSDPayoutAccount is the ID of the bank account which receives USD payouts.
+	DefaultUSDPayoutAccount string `json:"default_usd_payout_account,omitempty"`
+}
+
+// CreditorListOptions holds the parameters used to page through the results of ListCreditors
+type CreditorListOptions struct {
+	ListOptions
+}
@@ -44,3 +44,11 @@ type Customer struct {
 	// account is denominated in Swedish krona (SEK). This field cannot be changed once it has been set.
 	SwedishIdentityNumber string `json:"swedish_identity_number,omitempty"`
 }
+
+// LooksRemoved reports whether the customer appears to have been removed with RemoveCustomer. The API has no field
+// which marks a customer as removed, so this is a heuristic: a removed customer keeps its ID and CreatedAt, but
+// GoCardless blanks the personal data it held, including the name, company name and email. A customer which was
+// created without any of those values will therefore also be reported as removed
+func (c *Customer) LooksRemoved() bool {
+	return c.ID != `` && c.GivenName == `` && c.FamilyName == `` && c.CompanyName == `` && c.Email == ``
+}
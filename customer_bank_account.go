@@ -0,0 +1,64 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// CustomerBankAccount holds the details of a bank account belonging to a Customer, which may be used when creating a
+// mandate. Bank details may be supplied either as an IBAN, or as local details using the AccountNumber, BranchCode and
+// BankCode fields. The bank details themselves are never returned by the API
+type CustomerBankAccount struct {
+	// ID is a unique identifier, beginning with “BA”.
+	ID string `json:"id,omitempty"`
+	// AccountHolderName is the name of the account holder, as known by the bank. Usually this matches the name of the
+	// linked customer. This field will be transliterated, upcased and truncated to 18 characters.
+	AccountHolderName string `json:"account_holder_name,omitempty"`
+	// AccountNumber is the bank account number. Alternatively you can provide an IBAN. Only used on creation.
+	AccountNumber string `json:"account_number,omitempty"`
+	// AccountNumberEnding is the last two digits of the account number, as returned by the API.
+	AccountNumberEnding string `json:"account_number_ending,omitempty"`
+	// AccountType is the type of account, either “savings” or “checking”. Only required for USD accounts.
+	AccountType string `json:"account_type,omitempty"`
+	// BankCode is the bank code. Alternatively you can provide an IBAN. Only used on creation.
+	BankCode string `json:"bank_code,omitempty"`
+	// BankName is the name of the bank the account is held with, as returned by the API.
+	BankName string `json:"bank_name,omitempty"`
+	// BranchCode is the branch code, or sort code in the UK. Alternatively you can provide an IBAN. Only used on
+	// creation.
+	BranchCode string `json:"branch_code,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code. Defaults to the country code of the IBAN if supplied, otherwise is
+	// required.
+	CountryCode string `json:"country_code,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the bank account was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code. Defaults to the currency of the country if not supplied.
+	Currency string `json:"currency,omitempty"`
+	// Enabled is false once the bank account has been disabled, after which it may no longer be used.
+	Enabled bool `json:"enabled,omitempty"`
+	// IBAN is the international bank account number. Alternatively you can provide local details. Only used on
+	// creation.
+	IBAN string `json:"iban,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Links holds the IDs of the resources the bank account is associated with
+	Links *CustomerBankAccountLinks `json:"links,omitempty"`
+}
+
+// CustomerBankAccountLinks holds the IDs of the resources linked to a CustomerBankAccount
+type CustomerBankAccountLinks struct {
+	// Customer is the ID of the customer that owns this bank account.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccountToken is the ID of a customer bank account token to use in place of bank account
+	// parameters. Only used on creation.
+	CustomerBankAccountToken string `json:"customer_bank_account_token,omitempty"`
+}
+
+// CustomerBankAccountListOptions holds the parameters used to filter the results of ListCustomerBankAccounts
+type CustomerBankAccountListOptions struct {
+	ListOptions
+	// Customer restricts the results to bank accounts belonging to this customer ID
+	Customer string `url:"customer"`
+	// Enabled restricts the results to enabled or disabled bank accounts when set
+	Enabled *bool `url:"enabled"`
+}
@@ -14,6 +14,8 @@ const (
 	// of a single creditor. The following endpoints are therefore restricted:
 	//
 	// Creditors: Create
+	//
+	// Calling CreateCreditor with a Client configured for this environment returns a RestrictedEndpointError
 	LiveEnvironment Environment = `live`
 )
 
@@ -1,7 +1,9 @@
 package gocardless
 
 import (
-	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
 )
 
 const (
@@ -9,10 +11,53 @@ const (
 	InvalidMethodError = `The request Method is invalid`
 )
 
+const (
+	// ValidationFailedType is the type of error returned when the parameters of a request are invalid
+	ValidationFailedType = `validation_failed`
+	// InvalidAPIUsageType is the type of error returned when a request is malformed, is not authorised or refers to
+	// a resource which does not exist
+	InvalidAPIUsageType = `invalid_api_usage`
+	// InvalidStateType is the type of error returned when a request cannot be carried out in the current state of a
+	// resource, such as cancelling a payment which has already been paid out
+	InvalidStateType = `invalid_state`
+	// GoCardlessType is the type of error returned when GoCardless encountered an internal error
+	GoCardlessType = `gocardless`
+)
+
+const (
+	// RefundExceedsPaymentReason is the reason given when a refund would exceed the amount of its payment
+	RefundExceedsPaymentReason = `refund_exceeds_payment`
+	// TotalAmountConfirmationInvalidReason is the reason given when the total amount confirmation of a refund does
+	// not match the total amount refunded from the payment
+	TotalAmountConfirmationInvalidReason = `total_amount_confirmation_invalid`
+	// IdempotentCreationConflictReason is the reason given when a resource has already been created with the
+	// Idempotency-Key of a request. The ID of that resource is given in the Links of the ErrorDetail
+	IdempotentCreationConflictReason = `idempotent_creation_conflict`
+	// ResourceNotFoundReason is the reason given when the resource of a request does not exist
+	ResourceNotFoundReason = `resource_not_found`
+	// MandateIsInactiveReason is the reason given when a payment or subscription is created against a mandate which
+	// has been cancelled, has failed or has expired
+	MandateIsInactiveReason = `mandate_is_inactive`
+	// BankAccountDisabledReason is the reason given when a mandate is created against a bank account which has been
+	// disabled
+	BankAccountDisabledReason = `bank_account_disabled`
+)
+
 type errorContainer struct {
 	Error *Error `json:"error"`
 }
 
+// Error is an error returned by the GoCardless API. Responses are returned as the most specific of the error types in
+// this file, such as a ValidationError or a ResourceNotFoundError, each of which wraps the *Error so that its
+// RequestID, Details and HTTP status Code remain available. The error types are returned as values, so check for a
+// type by passing errors.As the address of a value, such as errors.As(err, &ValidationError{})
+//
+//     var validationErr ValidationError
+//     if errors.As(err, &validationErr) {
+//         for _, detail := range validationErr.Err.Details {
+//             fmt.Println(detail.Field, detail.Message)
+//         }
+//     }
 type Error struct {
 	DocumentationURL string         `json:"documentation_url"`
 	Message          string         `json:"message"`
@@ -22,18 +67,370 @@ type Error struct {
 	Code             int            `json:"code"`
 }
 
+// Error returns the message of the error followed by the message of each detail, and the ID of the request which
+// GoCardless support will ask for
 func (err *Error) Error() string {
-	data, _ := json.Marshal(err)
-	return string(data)
+	message := err.Message
+	for _, detail := range err.Details {
+		if detail.Field != `` {
+			message += fmt.Sprintf(`; %s %s`, detail.Field, detail.Message)
+		} else {
+			message += fmt.Sprintf(`; %s`, detail.Message)
+		}
+	}
+	if err.RequestID != `` {
+		message += fmt.Sprintf(` (request ID %s)`, err.RequestID)
+	}
+	return message
+}
+
+// newValidationError returns a ValidationError in the same form as the validation errors returned by the API,
+// allowing parameters to be checked before a request is sent
+func newValidationError(details ...*ErrorDetail) ValidationError {
+	return ValidationError{&Error{
+		Message: `Validation failed`,
+		Details: details,
+		Type:    ValidationFailedType,
+		Code:    http.StatusUnprocessableEntity,
+	}}
+}
+
+// typedError returns err as the type of error for its reason, or when it has no recognised reason the type for its
+// HTTP status or error type. The returned error unwraps to the error for the type of err, and then to err itself
+func typedError(err *Error) error {
+	switch {
+	case err.HasReason(ResourceNotFoundReason):
+		return ResourceNotFoundError{err}
+	case err.HasReason(MandateIsInactiveReason):
+		return MandateIsInactiveError{err}
+	case err.HasReason(BankAccountDisabledReason):
+		return BankAccountDisabledError{err}
+	case err.HasReason(IdempotentCreationConflictReason):
+		return IdempotentCreationConflictError{err}
+	case err.HasReason(RefundExceedsPaymentReason):
+		return RefundExceedsPaymentError{err}
+	case err.HasReason(TotalAmountConfirmationInvalidReason):
+		return TotalAmountConfirmationInvalidError{err}
+	}
+
+	switch err.Code {
+	case http.StatusUnauthorized:
+		return AuthenticationError{err}
+	case http.StatusForbidden:
+		return PermissionError{err}
+	}
+	return baseError(err)
+}
+
+// baseError returns err as the type of error for its error type. Errors without a type, which were built from the
+// status code of the response alone, are treated as GoCardless errors when the status is 5xx
+func baseError(err *Error) error {
+	switch err.Type {
+	case InvalidAPIUsageType:
+		return InvalidAPIUsageError{err}
+	case InvalidStateType:
+		return InvalidStateError{err}
+	case ValidationFailedType:
+		return ValidationError{err}
+	case GoCardlessType:
+		return GoCardlessError{err}
+	case ``:
+		if err.Code >= http.StatusInternalServerError {
+			return GoCardlessError{err}
+		}
+	}
+	return err
+}
+
+// HasReason returns true when any of the details of the error were caused by reason
+func (err *Error) HasReason(reason string) bool {
+	for _, detail := range err.Details {
+		if detail.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// ConflictingResourceID returns the ID of the resource already created with the Idempotency-Key of the request, or an
+// empty string when the error is not an idempotent creation conflict
+func (err *Error) ConflictingResourceID() string {
+	for _, detail := range err.Details {
+		if detail.Reason == IdempotentCreationConflictReason && detail.Links != nil {
+			return detail.Links.ConflictingResourceID
+		}
+	}
+	return ``
 }
 
 type ErrorDetail struct {
-	Message        string `json:"message"`
-	Field          string `json:"field"`
-	RequestPointer string `json:"request_pointer"`
+	Message        string            `json:"message"`
+	Field          string            `json:"field"`
+	RequestPointer string            `json:"request_pointer"`
+	Reason         string            `json:"reason,omitempty"`
+	Links          *ErrorDetailLinks `json:"links,omitempty"`
+}
+
+// ErrorDetailLinks holds the IDs of the resources linked to an ErrorDetail
+type ErrorDetailLinks struct {
+	// ConflictingResourceID is the ID of the resource which was created by an earlier request with the same
+	// Idempotency-Key.
+	ConflictingResourceID string `json:"conflicting_resource_id,omitempty"`
+}
+
+// InvalidAPIUsageError is returned when the API reports an invalid_api_usage error, such as a malformed request
+type InvalidAPIUsageError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err InvalidAPIUsageError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the underlying GoCardless error
+func (err InvalidAPIUsageError) Unwrap() error {
+	return err.Err
+}
+
+// InvalidStateError is returned when the API reports an invalid_state error, as the request cannot be carried out in
+// the current state of the resource
+type InvalidStateError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err InvalidStateError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the underlying GoCardless error
+func (err InvalidStateError) Unwrap() error {
+	return err.Err
+}
+
+// ValidationError is returned when the parameters of a request are invalid, either by the API or by the checks made
+// before a request is sent. The Details of Err describe the problem with each field
+type ValidationError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err ValidationError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the underlying GoCardless error
+func (err ValidationError) Unwrap() error {
+	return err.Err
+}
+
+// Field returns the details of the problems with the named field of the request
+func (err ValidationError) Field(name string) []*ErrorDetail {
+	var details []*ErrorDetail
+	for _, detail := range err.Err.Details {
+		if detail.Field == name {
+			details = append(details, detail)
+		}
+	}
+	return details
+}
+
+// GoCardlessError is returned when GoCardless encountered an internal error. The request may be retried, and if the
+// problem persists the RequestID should be given to GoCardless support
+type GoCardlessError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err GoCardlessError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the underlying GoCardless error
+func (err GoCardlessError) Unwrap() error {
+	return err.Err
+}
+
+// AuthenticationError is returned when the access token of the Client is missing, invalid or has been revoked
+type AuthenticationError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err AuthenticationError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err AuthenticationError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// PermissionError is returned when the access token of the Client does not permit the request, such as a read-only
+// token being used to create a resource
+type PermissionError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err PermissionError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err PermissionError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// ResourceNotFoundError is returned when the resource of a request does not exist
+type ResourceNotFoundError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err ResourceNotFoundError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err ResourceNotFoundError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// MandateIsInactiveError is returned when a payment or subscription is created against a mandate which can no longer
+// be used
+type MandateIsInactiveError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err MandateIsInactiveError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err MandateIsInactiveError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// BankAccountDisabledError is returned when a mandate is created against a bank account which has been disabled
+type BankAccountDisabledError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err BankAccountDisabledError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err BankAccountDisabledError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// IdempotentCreationConflictError is returned by the create methods when a resource has already been created with the
+// Idempotency-Key of the request. Err.ConflictingResourceID returns the ID of that resource
+type IdempotentCreationConflictError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err IdempotentCreationConflictError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err IdempotentCreationConflictError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// RefundExceedsPaymentError is returned by CreateRefund when the refund amount would exceed the amount of the payment
+// that has not yet been refunded
+type RefundExceedsPaymentError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err RefundExceedsPaymentError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err RefundExceedsPaymentError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// TotalAmountConfirmationInvalidError is returned by CreateRefund when the TotalAmountConfirmation does not match the
+// total amount that would have been refunded from the payment
+type TotalAmountConfirmationInvalidError struct {
+	// Err is the error returned by the API
+	Err *Error
+}
+
+func (err TotalAmountConfirmationInvalidError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the error for the type of the underlying GoCardless error
+func (err TotalAmountConfirmationInvalidError) Unwrap() error {
+	return baseError(err.Err)
+}
+
+// RestrictedEndpointError is returned when an endpoint may not be used in the Environment the Client is configured
+// for. The restrictions are documented on each Environment
+type RestrictedEndpointError struct {
+	// Endpoint is the name of the restricted endpoint
+	Endpoint string
+	// Environment is the environment in which the endpoint is restricted
+	Environment Environment
+}
+
+func (err *RestrictedEndpointError) Error() string {
+	return fmt.Sprintf(`%s is restricted in the %s environment`, err.Endpoint, err.Environment)
+}
+
+// EventInProgressError is returned by an EventStore when an event is claimed while another caller, such as another
+// replica of a webhook endpoint, holds the claim and has not yet completed or released it
+type EventInProgressError struct {
+	// EventID is the ID of the event being processed
+	EventID string
+}
+
+func (err *EventInProgressError) Error() string {
+	return fmt.Sprintf(`Event %s is already being processed`, err.EventID)
+}
+
+// InvalidWebhookSignatureError is returned when the Webhook-Signature of a webhook does not match its body. The
+// webhook may not have been sent by GoCardless, or may have been signed with a different secret, and must not be
+// processed
+type InvalidWebhookSignatureError struct{}
+
+func (err *InvalidWebhookSignatureError) Error() string {
+	return `The webhook signature is invalid`
+}
+
+// MalformedWebhookError is returned when the signature of a webhook is valid but its body could not be parsed
+type MalformedWebhookError struct {
+	// Err is the error encountered while parsing the body
+	Err error
+}
+
+func (err *MalformedWebhookError) Error() string {
+	return fmt.Sprintf(`The webhook body is malformed: %s`, err.Err)
+}
+
+// Unwrap returns the error encountered while parsing the body
+func (err *MalformedWebhookError) Unwrap() error {
+	return err.Err
 }
 
+// RateLimitedExceededError is returned when GoCardless has rejected a request because too many requests have been
+// made, and any retries permitted by the RetryPolicy of the Client have been exhausted
 type RateLimitedExceededError struct {
+	// RateReset is the time at which the rate limit will be reset. It is the zero time when GoCardless did not
+	// provide it
+	RateReset time.Time
 }
 
 func (err *RateLimitedExceededError) Error() string {
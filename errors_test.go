@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"encoding/json"
+	"errors"
 	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
 )
 
 func TestErrorUnmarshal(t *testing.T) {
@@ -95,3 +98,156 @@ func TestErrorDetailUnmarshal(t *testing.T) {
 		})
 	})
 }
+
+func TestErrorMessage(t *testing.T) {
+	Convey(`Given I have a GoCardless error with details`, t, func() {
+		err := &Error{
+			Message:   `Validation failed`,
+			RequestID: `REQ123`,
+			Details: []*ErrorDetail{
+				{Field: `branch_code`, Message: `must be a number`},
+				{Message: `Bank account already exists`},
+			},
+		}
+
+		Convey(`When I call the Error method`, func() {
+			message := err.Error()
+
+			Convey(`Then the message will describe each detail and the request`, func() {
+				So(message, ShouldEqual, `Validation failed; branch_code must be a number; Bank account already exists (request ID REQ123)`)
+			})
+		})
+	})
+}
+
+func TestTypedErrors(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		respond := func(status int, body string) error {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.WriteHeader(status)
+				w.Write([]byte(body))
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			_, err := client.GetMandate(`MD123`)
+			return err
+		}
+
+		Convey(`When the API returns a validation error`, func() {
+			err := respond(http.StatusUnprocessableEntity, `{"error": {
+				"type": "validation_failed",
+				"code": 422,
+				"message": "Validation failed",
+				"request_id": "REQ123",
+				"errors": [
+					{"field": "amount", "message": "must be greater than 0", "request_pointer": "/payments/amount"},
+					{"field": "currency", "message": "is invalid", "request_pointer": "/payments/currency"}
+				]
+			}}`)
+
+			Convey(`Then it will match the address of a ValidationError literal`, func() {
+				So(errors.As(err, &ValidationError{}), ShouldBeTrue)
+				So(errors.As(err, &ResourceNotFoundError{}), ShouldBeFalse)
+			})
+
+			Convey(`Then it will be a ValidationError`, func() {
+				var validationErr ValidationError
+				So(errors.As(err, &validationErr), ShouldBeTrue)
+				So(validationErr.Field(`amount`), ShouldHaveLength, 1)
+				So(validationErr.Field(`amount`)[0].Message, ShouldEqual, `must be greater than 0`)
+			})
+
+			Convey(`Then the underlying Error will keep the request ID and status`, func() {
+				var gcErr *Error
+				So(errors.As(err, &gcErr), ShouldBeTrue)
+				So(gcErr.RequestID, ShouldEqual, `REQ123`)
+				So(gcErr.Code, ShouldEqual, http.StatusUnprocessableEntity)
+				So(gcErr.Details, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey(`When the API returns a resource not found error`, func() {
+			err := respond(http.StatusNotFound, `{"error": {
+				"type": "invalid_api_usage",
+				"code": 404,
+				"message": "Resource not found",
+				"errors": [{"reason": "resource_not_found", "message": "Resource not found"}]
+			}}`)
+
+			Convey(`Then it will be a ResourceNotFoundError`, func() {
+				var notFoundErr ResourceNotFoundError
+				So(errors.As(err, &notFoundErr), ShouldBeTrue)
+			})
+
+			Convey(`Then it will also be an InvalidAPIUsageError`, func() {
+				var usageErr InvalidAPIUsageError
+				So(errors.As(err, &usageErr), ShouldBeTrue)
+			})
+		})
+
+		Convey(`When the API returns a mandate is inactive error`, func() {
+			err := respond(http.StatusUnprocessableEntity, `{"error": {
+				"type": "invalid_state",
+				"code": 422,
+				"message": "Mandate is inactive",
+				"errors": [{"reason": "mandate_is_inactive", "message": "Mandate is inactive"}]
+			}}`)
+
+			Convey(`Then it will be a MandateIsInactiveError and an InvalidStateError`, func() {
+				var inactiveErr MandateIsInactiveError
+				var stateErr InvalidStateError
+				var validationErr ValidationError
+				So(errors.As(err, &inactiveErr), ShouldBeTrue)
+				So(errors.As(err, &stateErr), ShouldBeTrue)
+				So(errors.As(err, &validationErr), ShouldBeFalse)
+			})
+		})
+
+		Convey(`When the API rejects the access token`, func() {
+			err := respond(http.StatusUnauthorized, `{"error": {
+				"type": "invalid_api_usage",
+				"code": 401,
+				"message": "Access token not active",
+				"errors": [{"reason": "access_token_not_active", "message": "Access token not active"}]
+			}}`)
+
+			Convey(`Then it will be an AuthenticationError`, func() {
+				var authErr AuthenticationError
+				So(errors.As(err, &authErr), ShouldBeTrue)
+				So(authErr.Err.Code, ShouldEqual, http.StatusUnauthorized)
+			})
+		})
+
+		Convey(`When the API refuses the request to the access token`, func() {
+			err := respond(http.StatusForbidden, `{"error": {
+				"type": "invalid_api_usage",
+				"code": 403,
+				"message": "Insufficient permissions",
+				"errors": [{"reason": "insufficient_permissions", "message": "Insufficient permissions"}]
+			}}`)
+
+			Convey(`Then it will be a PermissionError`, func() {
+				var permissionErr PermissionError
+				So(errors.As(err, &permissionErr), ShouldBeTrue)
+			})
+		})
+
+		Convey(`When the API has an internal error`, func() {
+			err := respond(http.StatusInternalServerError, `{"error": {
+				"type": "gocardless",
+				"code": 500,
+				"message": "Uh-oh!",
+				"request_id": "REQ456"
+			}}`)
+
+			Convey(`Then it will be a GoCardlessError`, func() {
+				var internalErr GoCardlessError
+				So(errors.As(err, &internalErr), ShouldBeTrue)
+				So(internalErr.Err.RequestID, ShouldEqual, `REQ456`)
+			})
+		})
+	})
+}
@@ -0,0 +1,150 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// ResourceType is the type of resource an Event relates to
+type ResourceType string
+
+const (
+	// ResourceTypeBillingRequests is the resource type of events relating to a BillingRequest
+	ResourceTypeBillingRequests ResourceType = `billing_requests`
+	// ResourceTypeCreditors is the resource type of events relating to a Creditor
+	ResourceTypeCreditors ResourceType = `creditors`
+	// ResourceTypeInstalmentSchedules is the resource type of events relating to an InstalmentSchedule
+	ResourceTypeInstalmentSchedules ResourceType = `instalment_schedules`
+	// ResourceTypeMandates is the resource type of events relating to a Mandate
+	ResourceTypeMandates ResourceType = `mandates`
+	// ResourceTypePayments is the resource type of events relating to a Payment
+	ResourceTypePayments ResourceType = `payments`
+	// ResourceTypePayouts is the resource type of events relating to a Payout
+	ResourceTypePayouts ResourceType = `payouts`
+	// ResourceTypeRefunds is the resource type of events relating to a Refund
+	ResourceTypeRefunds ResourceType = `refunds`
+	// ResourceTypeSubscriptions is the resource type of events relating to a Subscription
+	ResourceTypeSubscriptions ResourceType = `subscriptions`
+)
+
+// Event is created when a change happens to a resource, such as a payment being confirmed or a mandate being
+// cancelled. Events are also delivered to your application as webhooks
+type Event struct {
+	// ID is a unique identifier, beginning with “EV”.
+	ID string `json:"id,omitempty"`
+	// Action is what has happened to the resource, such as “created” or “cancelled”.
+	Action string `json:"action,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the event was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Details describes why the event happened.
+	Details *EventDetails `json:"details,omitempty"`
+	// Metadata is the metadata attached to the event, if it was caused by an API request with metadata.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// ResourceType is the type of resource the event relates to.
+	ResourceType ResourceType `json:"resource_type,omitempty"`
+	// Links holds the IDs of the resources the event relates to. Only the link matching the ResourceType is always
+	// present
+	Links *EventLinks `json:"links,omitempty"`
+}
+
+// EventDetails describes the cause of an Event
+type EventDetails struct {
+	// Cause is what triggered the event, such as “payment_confirmed” or “bank_account_closed”.
+	Cause string `json:"cause,omitempty"`
+	// Description is a human readable description of the cause.
+	Description string `json:"description,omitempty"`
+	// NotRetriedReason is the reason a failed payment will not be retried, when applicable.
+	NotRetriedReason string `json:"not_retried_reason,omitempty"`
+	// Origin is who initiated the event, one of “bank”, “api”, “gocardless” or “customer”.
+	Origin string `json:"origin,omitempty"`
+	// Property is the property of the resource which was changed, when applicable.
+	Property string `json:"property,omitempty"`
+	// ReasonCode is the scheme specific reason code given by the bank, when the origin is “bank”.
+	ReasonCode string `json:"reason_code,omitempty"`
+	// Scheme is the Direct Debit scheme of the reason code, when the origin is “bank”.
+	Scheme string `json:"scheme,omitempty"`
+	// WillAttemptRetry is true when a failed payment will be retried automatically.
+	WillAttemptRetry bool `json:"will_attempt_retry,omitempty"`
+}
+
+// EventLinks holds the IDs of the resources linked to an Event
+type EventLinks struct {
+	// BillingRequest is the ID of the billing request the event relates to.
+	BillingRequest string `json:"billing_request,omitempty"`
+	// Creditor is the ID of the creditor the event relates to.
+	Creditor string `json:"creditor,omitempty"`
+	// Customer is the ID of the customer the event relates to.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccount is the ID of the customer bank account the event relates to.
+	CustomerBankAccount string `json:"customer_bank_account,omitempty"`
+	// InstalmentSchedule is the ID of the instalment schedule the event relates to.
+	InstalmentSchedule string `json:"instalment_schedule,omitempty"`
+	// Mandate is the ID of the mandate the event relates to.
+	Mandate string `json:"mandate,omitempty"`
+	// NewCustomerBankAccount is the ID of the new customer bank account, when a mandate has been transferred.
+	NewCustomerBankAccount string `json:"new_customer_bank_account,omitempty"`
+	// NewMandate is the ID of the new mandate, when a mandate has been replaced.
+	NewMandate string `json:"new_mandate,omitempty"`
+	// Organisation is the ID of the organisation, when the event was created by an OAuth app.
+	Organisation string `json:"organisation,omitempty"`
+	// ParentEvent is the ID of the event which caused this event.
+	ParentEvent string `json:"parent_event,omitempty"`
+	// Payment is the ID of the payment the event relates to.
+	Payment string `json:"payment,omitempty"`
+	// Payout is the ID of the payout the event relates to.
+	Payout string `json:"payout,omitempty"`
+	// PreviousCustomerBankAccount is the ID of the previous customer bank account, when a mandate has been
+	// transferred.
+	PreviousCustomerBankAccount string `json:"previous_customer_bank_account,omitempty"`
+	// Refund is the ID of the refund the event relates to.
+	Refund string `json:"refund,omitempty"`
+	// Subscription is the ID of the subscription the event relates to.
+	Subscription string `json:"subscription,omitempty"`
+}
+
+// EventListOptions holds the parameters used to filter the results of ListEvents
+type EventListOptions struct {
+	ListOptions
+	// Action restricts the results to events with this action, such as “cancelled”
+	Action string `url:"action"`
+	// BillingRequest restricts the results to events relating to this billing request ID
+	BillingRequest string `url:"billing_request"`
+	// CreatedAfter restricts the results to events created after this time
+	CreatedAfter *time.Time `url:"created_at[gt]"`
+	// CreatedOnOrAfter restricts the results to events created at or after this time
+	CreatedOnOrAfter *time.Time `url:"created_at[gte]"`
+	// CreatedBefore restricts the results to events created before this time
+	CreatedBefore *time.Time `url:"created_at[lt]"`
+	// CreatedOnOrBefore restricts the results to events created at or before this time
+	CreatedOnOrBefore *time.Time `url:"created_at[lte]"`
+	// Include requests that the resources linked to each event are returned in the Linked collection. The value is
+	// the singular name of the ResourceType filter, such as “payment”, and may only be used alongside it
+	Include string `url:"include"`
+	// InstalmentSchedule restricts the results to events relating to this instalment schedule ID
+	InstalmentSchedule string `url:"instalment_schedule"`
+	// Mandate restricts the results to events relating to this mandate ID
+	Mandate string `url:"mandate"`
+	// ParentEvent restricts the results to events caused by this event ID
+	ParentEvent string `url:"parent_event"`
+	// Payment restricts the results to events relating to this payment ID
+	Payment string `url:"payment"`
+	// Payout restricts the results to events relating to this payout ID
+	Payout string `url:"payout"`
+	// Refund restricts the results to events relating to this refund ID
+	Refund string `url:"refund"`
+	// ResourceType restricts the results to events relating to this type of resource
+	ResourceType ResourceType `url:"resource_type"`
+	// Subscription restricts the results to events relating to this subscription ID
+	Subscription string `url:"subscription"`
+}
+
+// Linked holds the resources returned alongside a list of events when the Include option is used
+type Linked struct {
+	BillingRequests     []*BillingRequest     `json:"billing_requests,omitempty"`
+	Creditors           []*Creditor           `json:"creditors,omitempty"`
+	InstalmentSchedules []*InstalmentSchedule `json:"instalment_schedules,omitempty"`
+	Mandates            []*Mandate            `json:"mandates,omitempty"`
+	Payments            []*Payment            `json:"payments,omitempty"`
+	Payouts             []*Payout             `json:"payouts,omitempty"`
+	Refunds             []*Refund             `json:"refunds,omitempty"`
+	Subscriptions       []*Subscription       `json:"subscriptions,omitempty"`
+}
@@ -0,0 +1,366 @@
+package gocardless
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEventRetention is how long an EventStore remembers a processed event by default. GoCardless stops
+	// retrying a webhook well within this period
+	DefaultEventRetention = 30 * 24 * time.Hour
+	// DefaultEventClaimTimeout is how long an event may be claimed before it is assumed the process handling it has
+	// died, and the event may be claimed again
+	DefaultEventClaimTimeout = 5 * time.Minute
+
+	// memoryEvictionInterval is how often a MemoryEventStore removes its expired records
+	memoryEvictionInterval = time.Minute
+)
+
+// EventStore records which events have been processed, so that an event delivered more than once is only acted upon
+// once. Before processing an event it is claimed. Once processed it is completed, or if processing fails it is
+// released so it can be claimed again when GoCardless resends it
+type EventStore interface {
+	// Claim reports whether the caller may process the event with the given ID. It returns false when the event has
+	// already been processed, and an *EventInProgressError when it is currently claimed by another caller
+	Claim(eventID string) (bool, error)
+	// Complete records that the event with the given ID has been processed
+	Complete(eventID string) error
+	// Release gives up the claim on an event which could not be processed
+	Release(eventID string) error
+}
+
+// eventState is the state of an event held by an EventStore
+type eventState string
+
+const (
+	eventClaimed   eventState = `claimed`
+	eventCompleted eventState = `completed`
+)
+
+// eventRecord is the state of an event held by an EventStore, and when it entered that state
+type eventRecord struct {
+	State     eventState `json:"state"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// expired reports whether the record should be forgotten at now, given the retention and claim timeout of the store.
+// A retention or claim timeout which has not been set is replaced by its default
+func (r *eventRecord) expired(now time.Time, retention, claimTimeout time.Duration) bool {
+	if retention <= 0 {
+		retention = DefaultEventRetention
+	}
+	if claimTimeout <= 0 {
+		claimTimeout = DefaultEventClaimTimeout
+	}
+	if r.State == eventClaimed {
+		return now.Sub(r.UpdatedAt) > claimTimeout
+	}
+	return now.Sub(r.UpdatedAt) > retention
+}
+
+// claim returns the result of Claim for an event whose unexpired record is r, or for an event without one when r is
+// nil
+func (r *eventRecord) claim(eventID string) (bool, error) {
+	switch {
+	case r == nil:
+		return true, nil
+	case r.State == eventClaimed:
+		return false, &EventInProgressError{EventID: eventID}
+	default:
+		return false, nil
+	}
+}
+
+// MemoryEventStore is an EventStore held in memory. It is safe for concurrent use, but only deduplicates events
+// within a single process. The zero value is ready to use, remembering events for the DefaultEventRetention
+type MemoryEventStore struct {
+	// Retention is how long a processed event is remembered. When zero DefaultEventRetention is used.
+	Retention time.Duration
+	// ClaimTimeout is how long an event may be claimed without being completed or released before it may be claimed
+	// again. When zero DefaultEventClaimTimeout is used.
+	ClaimTimeout time.Duration
+
+	mutex       sync.Mutex
+	records     map[string]*eventRecord
+	lastEvicted time.Time
+	now         func() time.Time
+}
+
+// NewMemoryEventStore returns a MemoryEventStore which remembers processed events for the retention period. When the
+// retention is zero DefaultEventRetention is used
+func NewMemoryEventStore(retention time.Duration) *MemoryEventStore {
+	if retention <= 0 {
+		retention = DefaultEventRetention
+	}
+	return &MemoryEventStore{
+		Retention:    retention,
+		ClaimTimeout: DefaultEventClaimTimeout,
+		records:      map[string]*eventRecord{},
+		now:          time.Now,
+	}
+}
+
+// Claim reports whether the caller may process the event. Expired records are removed from the store at most once
+// every minute as it is called
+func (s *MemoryEventStore) Claim(eventID string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.clock()
+	if s.records == nil {
+		s.records = map[string]*eventRecord{}
+	}
+	if now.Sub(s.lastEvicted) >= memoryEvictionInterval {
+		for id, record := range s.records {
+			if record.expired(now, s.Retention, s.ClaimTimeout) {
+				delete(s.records, id)
+			}
+		}
+		s.lastEvicted = now
+	}
+
+	record := s.records[eventID]
+	if record != nil && record.expired(now, s.Retention, s.ClaimTimeout) {
+		record = nil
+	}
+	claimed, err := record.claim(eventID)
+	if claimed {
+		s.records[eventID] = &eventRecord{State: eventClaimed, UpdatedAt: now}
+	}
+	return claimed, err
+}
+
+// Complete records that the event has been processed
+func (s *MemoryEventStore) Complete(eventID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.records == nil {
+		s.records = map[string]*eventRecord{}
+	}
+	s.records[eventID] = &eventRecord{State: eventCompleted, UpdatedAt: s.clock()}
+	return nil
+}
+
+// Release gives up the claim on the event. Events which have been completed are unaffected
+func (s *MemoryEventStore) Release(eventID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if record, ok := s.records[eventID]; ok && record.State == eventClaimed {
+		delete(s.records, eventID)
+	}
+	return nil
+}
+
+// clock returns the current time, using time.Now when the store was not created with NewMemoryEventStore
+func (s *MemoryEventStore) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// eventIDPattern matches the event IDs a FileEventStore will accept, ensuring they are safe to use as file names
+var eventIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// fileLockName is the name of the file in the directory of a FileEventStore which is locked while a record is read and
+// written. It is never removed, so that every process locks the same file
+const fileLockName = `.lock`
+
+var (
+	// fileLockWait is how long a FileEventStore waits for the lock on its directory before giving up. The lock is only
+	// held while a record is read and written
+	fileLockWait = 15 * time.Second
+	// fileLockRetryInterval is how long a FileEventStore waits between attempts to take the lock on its directory
+	fileLockRetryInterval = 10 * time.Millisecond
+)
+
+// FileEventStore is an EventStore which keeps a file for each event in a directory. Several processes, such as
+// replicas of a webhook endpoint sharing a volume, may use the same directory. Changes to the events are guarded by an
+// advisory lock on a file in the directory, taken with flock on Unix systems and LockFileEx on Windows, which the
+// operating system releases if the process holding it dies. The directory must be on a filesystem which supports
+// those locks across every process using it, and the store returns an error on platforms without them
+type FileEventStore struct {
+	// Dir is the directory the event files are kept in.
+	Dir string
+	// Retention is how long a processed event is remembered. When zero DefaultEventRetention is used.
+	Retention time.Duration
+	// ClaimTimeout is how long an event may be claimed without being completed or released before it may be claimed
+	// again. When zero DefaultEventClaimTimeout is used.
+	ClaimTimeout time.Duration
+
+	now func() time.Time
+}
+
+// NewFileEventStore returns a FileEventStore keeping its files in dir, which is created if it does not exist.
+// Processed events are remembered for the retention period, or DefaultEventRetention when it is zero
+func NewFileEventStore(dir string, retention time.Duration) (*FileEventStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	if retention <= 0 {
+		retention = DefaultEventRetention
+	}
+	return &FileEventStore{
+		Dir:          dir,
+		Retention:    retention,
+		ClaimTimeout: DefaultEventClaimTimeout,
+		now:          time.Now,
+	}, nil
+}
+
+// Claim reports whether the caller may process the event
+func (s *FileEventStore) Claim(eventID string) (bool, error) {
+	var claimed bool
+	var claimErr error
+	err := s.update(eventID, func(record *eventRecord) *eventRecord {
+		if claimed, claimErr = record.claim(eventID); !claimed {
+			return record
+		}
+		return &eventRecord{State: eventClaimed, UpdatedAt: s.clock()}
+	})
+	if err != nil {
+		return false, err
+	}
+	return claimed, claimErr
+}
+
+// Complete records that the event has been processed
+func (s *FileEventStore) Complete(eventID string) error {
+	return s.update(eventID, func(_ *eventRecord) *eventRecord {
+		return &eventRecord{State: eventCompleted, UpdatedAt: s.clock()}
+	})
+}
+
+// Release gives up the claim on the event. Events which have been completed are unaffected
+func (s *FileEventStore) Release(eventID string) error {
+	return s.update(eventID, func(record *eventRecord) *eventRecord {
+		if record != nil && record.State == eventClaimed {
+			return nil
+		}
+		return record
+	})
+}
+
+// Prune removes the files of events which have expired. Expired events are ignored by the other methods, so Prune only
+// needs to be called periodically to reclaim disk space
+func (s *FileEventStore) Prune() error {
+	paths, err := filepath.Glob(filepath.Join(s.Dir, `*.json`))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		eventID := strings.TrimSuffix(filepath.Base(path), `.json`)
+		if err := s.update(eventID, func(record *eventRecord) *eventRecord { return record }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update locks the event and replaces its record with the result of fn, which is passed nil when there is no record
+// or it has expired. When fn returns nil the record is removed
+func (s *FileEventStore) update(eventID string, fn func(*eventRecord) *eventRecord) error {
+	if !eventIDPattern.MatchString(eventID) {
+		return fmt.Errorf(`%q is not a valid event ID`, eventID)
+	}
+
+	unlock, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	path := filepath.Join(s.Dir, eventID+`.json`)
+	record, err := s.read(path)
+	if err != nil {
+		return err
+	}
+	if record != nil && record.expired(s.clock(), s.Retention, s.ClaimTimeout) {
+		record = nil
+	}
+
+	updated := fn(record)
+	if updated == nil {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	if updated == record {
+		return nil
+	}
+
+	data, err := json.Marshal(updated)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file and rename it into place, so a record is never seen partially written
+	tmp := fmt.Sprintf(`%s.%d.tmp`, path, os.Getpid())
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// read returns the record in the file at path, or nil when the file does not exist
+func (s *FileEventStore) read(path string) (*eventRecord, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	record := &eventRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// clock returns the current time, using time.Now when the store was not created with NewFileEventStore
+func (s *FileEventStore) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// lock takes the lock on the directory of the store, waiting up to fileLockWait while another process or goroutine
+// holds it
+func (s *FileEventStore) lock() (func(), error) {
+	file, err := os.OpenFile(filepath.Join(s.Dir, fileLockName), os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(fileLockWait)
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		if locked {
+			return func() {
+				unlockFile(file)
+				file.Close()
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			file.Close()
+			return nil, fmt.Errorf(`timed out waiting for the lock on %s`, s.Dir)
+		}
+		time.Sleep(fileLockRetryInterval)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris && !windows
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris,!windows
+
+package gocardless
+
+import (
+	"errors"
+	"os"
+)
+
+// tryLockFile returns an error, as FileEventStore does not support locking files on this platform
+func tryLockFile(file *os.File) (bool, error) {
+	return false, errors.New(`gocardless: FileEventStore is not supported on this platform`)
+}
+
+// unlockFile does nothing, as tryLockFile never takes a lock on this platform
+func unlockFile(file *os.File) error {
+	return nil
+}
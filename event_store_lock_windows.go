@@ -0,0 +1,48 @@
+//go:build windows
+// +build windows
+
+package gocardless
+
+import (
+	"errors"
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+const (
+	lockfileFailImmediately = 0x1
+	lockfileExclusiveLock   = 0x2
+	errorLockViolation      = syscall.Errno(33)
+)
+
+var (
+	kernel32         = syscall.NewLazyDLL(`kernel32.dll`)
+	procLockFileEx   = kernel32.NewProc(`LockFileEx`)
+	procUnlockFileEx = kernel32.NewProc(`UnlockFileEx`)
+)
+
+// tryLockFile takes an exclusive LockFileEx lock on the first byte of file without waiting, returning false if another
+// handle holds it
+func tryLockFile(file *os.File) (bool, error) {
+	overlapped := new(syscall.Overlapped)
+	result, _, err := procLockFileEx.Call(file.Fd(), lockfileExclusiveLock|lockfileFailImmediately, 0, 1, 0,
+		uintptr(unsafe.Pointer(overlapped)))
+	if result != 0 {
+		return true, nil
+	}
+	if errors.Is(err, errorLockViolation) {
+		return false, nil
+	}
+	return false, err
+}
+
+// unlockFile releases the lock taken on file by tryLockFile
+func unlockFile(file *os.File) error {
+	overlapped := new(syscall.Overlapped)
+	result, _, err := procUnlockFileEx.Call(file.Fd(), 0, 1, 0, uintptr(unsafe.Pointer(overlapped)))
+	if result == 0 {
+		return err
+	}
+	return nil
+}
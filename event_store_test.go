@@ -0,0 +1,388 @@
+package gocardless
+
+import (
+	"testing"
+
+	"bytes"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+func TestEventStores(t *testing.T) {
+	stores := map[string]func(now func() time.Time) (EventStore, func()){
+		`MemoryEventStore`: func(now func() time.Time) (EventStore, func()) {
+			store := NewMemoryEventStore(time.Hour)
+			store.ClaimTimeout = time.Minute
+			store.now = now
+			return store, func() {}
+		},
+		`FileEventStore`: func(now func() time.Time) (EventStore, func()) {
+			dir, _ := ioutil.TempDir(``, `events`)
+			store, _ := NewFileEventStore(filepath.Join(dir, `store`), time.Hour)
+			store.ClaimTimeout = time.Minute
+			store.now = now
+			return store, func() { os.RemoveAll(dir) }
+		},
+	}
+
+	for name, newStore := range stores {
+		Convey(`Given I have a `+name, t, func() {
+			clock := time.Date(2018, 3, 12, 14, 0, 0, 0, time.UTC)
+			store, cleanup := newStore(func() time.Time { return clock })
+			defer cleanup()
+
+			Convey(`When I claim an event`, func() {
+				claimed, err := store.Claim(`EV123`)
+
+				Convey(`Then the claim will succeed`, func() {
+					So(err, ShouldBeNil)
+					So(claimed, ShouldBeTrue)
+				})
+
+				Convey(`Then claiming the event while it is being processed will return an EventInProgressError`, func() {
+					claimed, err := store.Claim(`EV123`)
+					So(err, ShouldHaveSameTypeAs, &EventInProgressError{})
+					So(err.(*EventInProgressError).EventID, ShouldEqual, `EV123`)
+					So(claimed, ShouldBeFalse)
+				})
+
+				Convey(`Then other events can be claimed`, func() {
+					claimed, _ := store.Claim(`EV456`)
+					So(claimed, ShouldBeTrue)
+				})
+
+				Convey(`And the claim times out`, func() {
+					clock = clock.Add(2 * time.Minute)
+
+					Convey(`Then the event can be claimed again`, func() {
+						claimed, _ := store.Claim(`EV123`)
+						So(claimed, ShouldBeTrue)
+					})
+				})
+
+				Convey(`And I release the event`, func() {
+					So(store.Release(`EV123`), ShouldBeNil)
+
+					Convey(`Then the event can be claimed again`, func() {
+						claimed, _ := store.Claim(`EV123`)
+						So(claimed, ShouldBeTrue)
+					})
+				})
+
+				Convey(`And I complete the event`, func() {
+					So(store.Complete(`EV123`), ShouldBeNil)
+
+					Convey(`Then claiming the event will report it as processed without an error`, func() {
+						claimed, err := store.Claim(`EV123`)
+						So(err, ShouldBeNil)
+						So(claimed, ShouldBeFalse)
+					})
+
+					Convey(`Then the event cannot be claimed after the claim timeout`, func() {
+						clock = clock.Add(30 * time.Minute)
+						claimed, _ := store.Claim(`EV123`)
+						So(claimed, ShouldBeFalse)
+					})
+
+					Convey(`Then releasing the event will not allow it to be claimed`, func() {
+						So(store.Release(`EV123`), ShouldBeNil)
+						claimed, _ := store.Claim(`EV123`)
+						So(claimed, ShouldBeFalse)
+					})
+
+					Convey(`Then the event can be claimed once the retention period has passed`, func() {
+						clock = clock.Add(2 * time.Hour)
+						claimed, _ := store.Claim(`EV123`)
+						So(claimed, ShouldBeTrue)
+					})
+				})
+			})
+
+			Convey(`When several goroutines claim the same event at once`, func() {
+				var wg sync.WaitGroup
+				var mutex sync.Mutex
+				successes := 0
+				for i := 0; i < 20; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						if claimed, err := store.Claim(`EV789`); err == nil && claimed {
+							mutex.Lock()
+							successes++
+							mutex.Unlock()
+						}
+					}()
+				}
+				wg.Wait()
+
+				Convey(`Then only one claim will succeed`, func() {
+					So(successes, ShouldEqual, 1)
+				})
+			})
+		})
+	}
+}
+
+func TestFileEventStore(t *testing.T) {
+	Convey(`Given I have two FileEventStores sharing a directory`, t, func() {
+		dir, _ := ioutil.TempDir(``, `events`)
+		defer os.RemoveAll(dir)
+		first, _ := NewFileEventStore(dir, time.Hour)
+		second, _ := NewFileEventStore(dir, time.Hour)
+
+		Convey(`When the first store claims an event`, func() {
+			claimed, _ := first.Claim(`EV123`)
+			So(claimed, ShouldBeTrue)
+
+			Convey(`Then the second store cannot claim it while it is in progress`, func() {
+				claimed, err := second.Claim(`EV123`)
+				So(claimed, ShouldBeFalse)
+				So(err, ShouldHaveSameTypeAs, &EventInProgressError{})
+			})
+		})
+
+		Convey(`And another process holds the lock on the directory`, func() {
+			defer func(wait time.Duration) { fileLockWait = wait }(fileLockWait)
+			fileLockWait = 50 * time.Millisecond
+			held, _ := os.OpenFile(filepath.Join(dir, fileLockName), os.O_CREATE|os.O_RDWR, 0600)
+			defer held.Close()
+			locked, _ := tryLockFile(held)
+			So(locked, ShouldBeTrue)
+
+			Convey(`When I claim an event while the lock is held for longer than the store will wait`, func() {
+				_, err := first.Claim(`EV123`)
+
+				Convey(`Then an error will be returned`, func() {
+					So(err, ShouldNotBeNil)
+				})
+
+				Convey(`Then the event will not have been claimed`, func() {
+					_, err := os.Stat(filepath.Join(dir, `EV123.json`))
+					So(os.IsNotExist(err), ShouldBeTrue)
+				})
+			})
+
+			Convey(`When the lock is released, such as by the process dying, and I claim an event`, func() {
+				unlockFile(held)
+				claimed, err := first.Claim(`EV123`)
+
+				Convey(`Then the event will be claimed`, func() {
+					So(err, ShouldBeNil)
+					So(claimed, ShouldBeTrue)
+				})
+			})
+		})
+
+		Convey(`When I create a FileEventStore without a retention period`, func() {
+			store, err := NewFileEventStore(dir, 0)
+
+			Convey(`Then the default retention will be used`, func() {
+				So(err, ShouldBeNil)
+				So(store.Retention, ShouldEqual, DefaultEventRetention)
+			})
+		})
+
+		Convey(`When I claim an event with an ID which is not safe to use as a file name`, func() {
+			_, err := first.Claim(`../EV123`)
+
+			Convey(`Then an error will be returned`, func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey(`When I prune the store after events have expired`, func() {
+			first.Complete(`EV123`)
+			first.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+			err := first.Prune()
+
+			Convey(`Then the event files will be removed`, func() {
+				So(err, ShouldBeNil)
+				paths, _ := filepath.Glob(filepath.Join(dir, `*.json`))
+				So(paths, ShouldBeEmpty)
+			})
+		})
+	})
+}
+
+func TestMemoryEventStore(t *testing.T) {
+	Convey(`Given I have a zero value MemoryEventStore`, t, func() {
+		store := &MemoryEventStore{}
+
+		Convey(`When I claim and complete an event`, func() {
+			claimed, err := store.Claim(`EV123`)
+			completeErr := store.Complete(`EV456`)
+
+			Convey(`Then the store will be usable`, func() {
+				So(err, ShouldBeNil)
+				So(claimed, ShouldBeTrue)
+				So(completeErr, ShouldBeNil)
+			})
+		})
+	})
+
+	Convey(`Given I create a MemoryEventStore without a retention period`, t, func() {
+		store := NewMemoryEventStore(0)
+
+		Convey(`Then the default retention will be used`, func() {
+			So(store.Retention, ShouldEqual, DefaultEventRetention)
+		})
+	})
+
+	Convey(`Given I have a MemoryEventStore holding an expired event`, t, func() {
+		clock := time.Date(2018, 3, 12, 14, 0, 0, 0, time.UTC)
+		store := NewMemoryEventStore(time.Second)
+		store.now = func() time.Time { return clock }
+		store.Claim(`EV123`)
+		store.Complete(`EV123`)
+		clock = clock.Add(memoryEvictionInterval)
+
+		Convey(`When I claim another event once the eviction interval has passed`, func() {
+			store.Claim(`EV456`)
+
+			Convey(`Then the expired event will be evicted`, func() {
+				So(store.records, ShouldNotContainKey, `EV123`)
+			})
+
+			Convey(`And another event expires before the eviction interval has passed again`, func() {
+				store.Complete(`EV456`)
+				clock = clock.Add(2 * time.Second)
+
+				Convey(`When I claim a further event`, func() {
+					store.Claim(`EV789`)
+
+					Convey(`Then the expired event will not be evicted yet`, func() {
+						So(store.records, ShouldContainKey, `EV456`)
+					})
+
+					Convey(`Then the expired event can still be claimed`, func() {
+						claimed, err := store.Claim(`EV456`)
+						So(err, ShouldBeNil)
+						So(claimed, ShouldBeTrue)
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestWebhookHandlerWithEventStore(t *testing.T) {
+	Convey(`Given I have a webhook handler using an event store`, t, func() {
+		handler := NewWebhookHandler(webhookSecret)
+		handler.UseEventStore(NewMemoryEventStore(DefaultEventRetention))
+
+		fail := false
+		confirmed := 0
+		handler.OnPayment(`confirmed`, func(event *Event) error {
+			if fail {
+				return errors.New(`database unavailable`)
+			}
+			confirmed++
+			return nil
+		})
+
+		send := func() int {
+			body := []byte(webhookBody)
+			req := httptest.NewRequest(http.MethodPost, `/webhooks`, bytes.NewReader(body))
+			req.Header.Set(WebhookSignatureHeader, signWebhook(webhookSecret, body))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			return recorder.Code
+		}
+
+		Convey(`When the same webhook is delivered twice`, func() {
+			first := send()
+			second := send()
+
+			Convey(`Then both deliveries will be accepted`, func() {
+				So(first, ShouldEqual, http.StatusNoContent)
+				So(second, ShouldEqual, http.StatusNoContent)
+			})
+
+			Convey(`Then the event will only be handled once`, func() {
+				So(confirmed, ShouldEqual, 1)
+			})
+		})
+
+		Convey(`When the webhook is delivered to another replica sharing the store while the event is being handled`, func() {
+			replica := NewWebhookHandler(webhookSecret)
+			replica.UseEventStore(handler.store)
+			started := make(chan struct{})
+			finish := make(chan struct{})
+			replica.OnPayment(`confirmed`, func(event *Event) error {
+				close(started)
+				<-finish
+				confirmed++
+				return nil
+			})
+
+			replicaCode := make(chan int)
+			go func() {
+				body := []byte(webhookBody)
+				req := httptest.NewRequest(http.MethodPost, `/webhooks`, bytes.NewReader(body))
+				req.Header.Set(WebhookSignatureHeader, signWebhook(webhookSecret, body))
+				recorder := httptest.NewRecorder()
+				replica.ServeHTTP(recorder, req)
+				replicaCode <- recorder.Code
+			}()
+			<-started
+			inFlight := send()
+			close(finish)
+			replicaDelivery := <-replicaCode
+			retried := send()
+
+			Convey(`Then the delivery will be rejected with a retryable status while the event is in progress`, func() {
+				So(inFlight, ShouldEqual, http.StatusConflict)
+			})
+
+			Convey(`Then the delivery will be accepted once the replica has completed the event`, func() {
+				So(replicaDelivery, ShouldEqual, http.StatusNoContent)
+				So(retried, ShouldEqual, http.StatusNoContent)
+			})
+
+			Convey(`Then the event will only be handled once`, func() {
+				So(confirmed, ShouldEqual, 1)
+			})
+		})
+
+		Convey(`When the first delivery fails`, func() {
+			fail = true
+			first := send()
+			fail = false
+			second := send()
+
+			Convey(`Then the retried delivery will handle the event`, func() {
+				So(first, ShouldEqual, http.StatusInternalServerError)
+				So(second, ShouldEqual, http.StatusNoContent)
+				So(confirmed, ShouldEqual, 1)
+			})
+		})
+
+		Convey(`When the handler fails and the claim on the event cannot be released`, func() {
+			fail = true
+			handler.UseEventStore(&unreleasableEventStore{NewMemoryEventStore(DefaultEventRetention)})
+			err := handler.handle(&Event{ID: `EV123`, ResourceType: `payments`, Action: `confirmed`})
+
+			Convey(`Then both errors will be returned`, func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, `database unavailable`)
+				So(err.Error(), ShouldContainSubstring, `disk full`)
+			})
+		})
+	})
+}
+
+// unreleasableEventStore is an EventStore whose Release always fails
+type unreleasableEventStore struct {
+	*MemoryEventStore
+}
+
+func (s *unreleasableEventStore) Release(eventID string) error {
+	return errors.New(`disk full`)
+}
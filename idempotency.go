@@ -0,0 +1,94 @@
+package gocardless
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// idempotencyKeyContextKey is the key of the Idempotency-Key set by WithIdempotencyKey in a context
+type idempotencyKeyContextKey struct{}
+
+// fetchableEndpoints are the create endpoints whose resources can be retrieved by ID, allowing the resource created by
+// an earlier request to be recovered from an idempotent creation conflict
+var fetchableEndpoints = map[string]bool{
+	billingRequestEndpoint:         true,
+	billingRequestTemplateEndpoint: true,
+	creditorEndpoint:               true,
+	creditorBankAccountEndpoint:    true,
+	customerEndpoint:               true,
+	customerBankAccountEndpoint:    true,
+	instalmentScheduleEndpoint:     true,
+	mandateEndpoint:                true,
+	mandateImportEndpoint:          true,
+	paymentEndpoint:                true,
+	redirectFlowEndpoint:           true,
+	refundEndpoint:                 true,
+	subscriptionEndpoint:           true,
+}
+
+// WithIdempotencyKey returns a copy of ctx which makes every create method called with it, or with a context derived
+// from it, send key as the Idempotency-Key header instead of a randomly generated one. Repeating a create request with
+// the same key, such as when retrying after a network error, will not create a second resource. Instead GoCardless
+// returns an idempotent_creation_conflict error, or when the Client has RecoverIdempotentConflicts set the resource
+// created by the first request is returned. A key identifies a single resource, so a new key should be set for each
+// resource to be created
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// newIdempotencyKey returns a random version 4 UUID for use as the Idempotency-Key header of a request
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ``, err
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf(`%x-%x-%x-%x-%x`, b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// idempotencyHeader returns a header containing the Idempotency-Key set in ctx by WithIdempotencyKey, or a newly
+// generated key when none has been set
+func idempotencyHeader(ctx context.Context) (http.Header, error) {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	if key == `` {
+		var err error
+		if key, err = newIdempotencyKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	header := http.Header{}
+	header.Set(`Idempotency-Key`, key)
+	return header, nil
+}
+
+// create sends body to the create endpoint at path with an Idempotency-Key, unmarshalling the created resource into
+// result. When the request conflicts with an earlier one and RecoverIdempotentConflicts is set, or the request has been
+// retried so that the earlier request was probably one of its own attempts, the resource created by the earlier request
+// is fetched into result instead, provided path is one of the fetchableEndpoints. If it cannot be fetched the conflict
+// error is returned
+func (c *Client) create(ctx context.Context, path string, body, result interface{}) error {
+	header, err := idempotencyHeader(ctx)
+	if err != nil {
+		return err
+	}
+
+	attempts, err := c.executeAttempts(ctx, http.MethodPost, path, header, body, result)
+	if err == nil || !fetchableEndpoints[path] || (!c.RecoverIdempotentConflicts && attempts <= 1) {
+		return err
+	}
+
+	var gcErr *Error
+	if !errors.As(err, &gcErr) || gcErr.ConflictingResourceID() == `` {
+		return err
+	}
+	conflictPath := fmt.Sprintf(`%s/%s`, path, gcErr.ConflictingResourceID())
+	if fetchErr := c.execute(ctx, http.MethodGet, conflictPath, nil, result); fetchErr != nil {
+		return err
+	}
+	return nil
+}
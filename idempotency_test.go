@@ -0,0 +1,184 @@
+package gocardless
+
+import (
+	"testing"
+
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+)
+
+const idempotentConflictResponse = `{
+	"error": {
+		"message": "A resource has already been created with this idempotency key",
+		"documentation_url": "https://developer.gocardless.com/api-reference#idempotent_creation_conflict",
+		"type": "invalid_state",
+		"request_id": "0AA000AAAAAAAA0A0AAA0AAA",
+		"code": 409,
+		"errors": [
+			{
+				"reason": "idempotent_creation_conflict",
+				"message": "A resource has already been created with this idempotency key",
+				"links": {
+					"conflicting_resource_id": "CU123"
+				}
+			}
+		]
+	}
+}`
+
+func TestClientCreateIdempotency(t *testing.T) {
+	Convey(`Given I have a client`, t, func() {
+		client := &Client{}
+
+		Convey(`And I have a server which records the Idempotency-Key of each request`, func() {
+			var keys []string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				keys = append(keys, req.Header.Get(`Idempotency-Key`))
+				w.WriteHeader(http.StatusCreated)
+				w.Write([]byte(`{"customers": {"id": "CU001"}}`))
+			}))
+
+			client.RemoteURL = srv.URL
+
+			Convey(`When I create two customers`, func() {
+				client.CreateCustomer(&Customer{})
+				client.CreateCustomer(&Customer{})
+
+				Convey(`Then a different key will be generated for each request`, func() {
+					So(keys, ShouldHaveLength, 2)
+					So(keys[0], ShouldHaveLength, 36)
+					So(keys[1], ShouldHaveLength, 36)
+					So(keys[0], ShouldNotEqual, keys[1])
+				})
+			})
+
+			Convey(`When I create a customer with a context holding an Idempotency-Key`, func() {
+				ctx := WithIdempotencyKey(context.Background(), `signup-42`)
+				client.CreateCustomerWithContext(ctx, &Customer{})
+
+				Convey(`Then the key will be sent`, func() {
+					So(keys, ShouldResemble, []string{`signup-42`})
+				})
+
+				Convey(`And I retry the create with the same context`, func() {
+					client.CreateCustomerWithContext(ctx, &Customer{})
+
+					Convey(`Then the same key will be sent again`, func() {
+						So(keys, ShouldResemble, []string{`signup-42`, `signup-42`})
+					})
+				})
+
+				Convey(`And I retry the create with a context derived from it`, func() {
+					derived, cancel := context.WithCancel(ctx)
+					defer cancel()
+					client.CreateCustomerWithContext(derived, &Customer{})
+
+					Convey(`Then the same key will be sent again`, func() {
+						So(keys, ShouldResemble, []string{`signup-42`, `signup-42`})
+					})
+				})
+			})
+
+			Convey(`When a create with an Idempotency-Key fails before it is sent`, func() {
+				ctx, cancel := context.WithCancel(WithIdempotencyKey(context.Background(), `signup-43`))
+				cancel()
+				err := client.CreateCustomerWithContext(ctx, &Customer{})
+
+				Convey(`And I retry the create with the key`, func() {
+					client.CreateCustomerWithContext(WithIdempotencyKey(context.Background(), `signup-43`), &Customer{})
+
+					Convey(`Then the key will be sent by the retry`, func() {
+						So(err, ShouldNotBeNil)
+						So(keys, ShouldResemble, []string{`signup-43`})
+					})
+				})
+			})
+
+			Convey(`When I create a payment`, func() {
+				client.CreatePayment(&Payment{})
+
+				Convey(`Then a key will be sent`, func() {
+					So(keys, ShouldHaveLength, 1)
+					So(keys[0], ShouldNotEqual, ``)
+				})
+			})
+		})
+
+		Convey(`And I have a server which reports an idempotent creation conflict`, func() {
+			var requests []string
+			getStatus := http.StatusOK
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+` `+req.URL.Path)
+				if req.Method == http.MethodPost {
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(idempotentConflictResponse))
+					return
+				}
+				w.WriteHeader(getStatus)
+				w.Write([]byte(`{"customers": {"id": "CU123", "given_name": "Frank"}}`))
+			}))
+
+			client.RemoteURL = srv.URL
+			customer := &Customer{GivenName: `Frank`}
+
+			Convey(`When I create a customer`, func() {
+				err := client.CreateCustomer(customer)
+
+				Convey(`Then the conflict will be returned as an error`, func() {
+					So(err, ShouldHaveSameTypeAs, IdempotentCreationConflictError{})
+					So(err.(IdempotentCreationConflictError).Err.ConflictingResourceID(), ShouldEqual, `CU123`)
+				})
+
+				Convey(`Then the existing customer will not be fetched`, func() {
+					So(requests, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey(`And the client recovers idempotent conflicts`, func() {
+				client.RecoverIdempotentConflicts = true
+
+				Convey(`When I create a customer`, func() {
+					err := client.CreateCustomer(customer)
+
+					Convey(`Then the error will be nil`, func() {
+						So(err, ShouldBeNil)
+					})
+
+					Convey(`Then the existing customer will be fetched`, func() {
+						So(requests, ShouldResemble, []string{`POST /customers`, `GET /customers/CU123`})
+						So(customer.ID, ShouldEqual, `CU123`)
+					})
+				})
+
+				Convey(`When I add a mandate import entry, which cannot be fetched by ID`, func() {
+					err := client.AddMandateImportEntry(&MandateImportEntry{})
+
+					Convey(`Then the conflict will be returned as an error`, func() {
+						So(err, ShouldHaveSameTypeAs, IdempotentCreationConflictError{})
+					})
+
+					Convey(`Then no attempt will be made to fetch the entry`, func() {
+						So(requests, ShouldResemble, []string{`POST /mandate_import_entries`})
+					})
+				})
+
+				Convey(`And the existing customer cannot be fetched`, func() {
+					getStatus = http.StatusNotFound
+
+					Convey(`When I create a customer`, func() {
+						err := client.CreateCustomer(customer)
+
+						Convey(`Then the conflict will be returned as an error`, func() {
+							So(err, ShouldNotBeNil)
+							So(err.(IdempotentCreationConflictError).Err.ConflictingResourceID(), ShouldEqual, `CU123`)
+						})
+					})
+				})
+			})
+		})
+	})
+}
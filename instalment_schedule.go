@@ -0,0 +1,101 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// InstalmentScheduleStatus describes the state of an InstalmentSchedule
+type InstalmentScheduleStatus string
+
+const (
+	// InstalmentSchedulePending means the schedule has been created, but its payments have not yet been created
+	InstalmentSchedulePending InstalmentScheduleStatus = `pending`
+	// InstalmentScheduleActive means the payments of the schedule have been created
+	InstalmentScheduleActive InstalmentScheduleStatus = `active`
+	// InstalmentScheduleCreationFailed means the payments of the schedule could not be created
+	InstalmentScheduleCreationFailed InstalmentScheduleStatus = `creation_failed`
+	// InstalmentScheduleCompleted means all of the payments of the schedule have been collected
+	InstalmentScheduleCompleted InstalmentScheduleStatus = `completed`
+	// InstalmentScheduleCancelled means the schedule has been cancelled
+	InstalmentScheduleCancelled InstalmentScheduleStatus = `cancelled`
+	// InstalmentScheduleErrored means one or more of the payments of the schedule has failed
+	InstalmentScheduleErrored InstalmentScheduleStatus = `errored`
+)
+
+// InstalmentSchedule creates a fixed series of Payments against a Mandate, which together add up to the TotalAmount.
+// The instalments are given either as explicit dates, using CreateInstalmentScheduleWithDates, or as a regular
+// schedule, using CreateInstalmentScheduleWithSchedule
+type InstalmentSchedule struct {
+	// ID is a unique identifier, beginning with “IS”.
+	ID string `json:"id,omitempty"`
+	// AppFee is the amount to be deducted from each payment as the OAuth app’s fee, in minor unit.
+	AppFee int `json:"app_fee,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the schedule was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code, such as “GBP” or “EUR”.
+	Currency string `json:"currency,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Name is a human-readable name for the schedule. This will be set as the description on each payment created.
+	Name string `json:"name,omitempty"`
+	// PaymentErrors holds the errors for each instalment which could not be created, keyed by the index of the
+	// instalment, as returned by the API.
+	PaymentErrors map[string][]*ErrorDetail `json:"payment_errors,omitempty"`
+	// PaymentReference is an optional payment reference that will appear on your customer’s bank statement.
+	PaymentReference string `json:"payment_reference,omitempty"`
+	// RetryIfPossible will cause failed payments created by the schedule to be retried automatically when set.
+	RetryIfPossible bool `json:"retry_if_possible,omitempty"`
+	// Status is the current state of the schedule.
+	Status InstalmentScheduleStatus `json:"status,omitempty"`
+	// TotalAmount is the total amount of the schedule, in minor unit. The amounts of the instalments must add up to
+	// this value.
+	TotalAmount int `json:"total_amount,omitempty"`
+	// Links holds the IDs of the resources the schedule is associated with
+	Links *InstalmentScheduleLinks `json:"links,omitempty"`
+}
+
+// InstalmentScheduleLinks holds the IDs of the resources linked to an InstalmentSchedule
+type InstalmentScheduleLinks struct {
+	// Customer is the ID of the customer the schedule collects from, as returned by the API.
+	Customer string `json:"customer,omitempty"`
+	// Mandate is the ID of the mandate against which payments will be collected.
+	Mandate string `json:"mandate,omitempty"`
+	// Payments are the IDs of the payments created by the schedule, as returned by the API.
+	Payments []string `json:"payments,omitempty"`
+}
+
+// Instalment is a single payment of an InstalmentSchedule created with explicit dates
+type Instalment struct {
+	// Amount is the amount of the instalment, in minor unit.
+	Amount int `json:"amount"`
+	// ChargeDate is the date, in the format YYYY-MM-DD, on which the instalment should be collected. If left blank
+	// the instalment is collected as soon as possible.
+	ChargeDate string `json:"charge_date,omitempty"`
+	// Description is a human-readable description of the instalment.
+	Description string `json:"description,omitempty"`
+}
+
+// InstalmentPlan describes the regular schedule of an InstalmentSchedule created with a schedule
+type InstalmentPlan struct {
+	// Amounts are the amounts of each instalment, in minor unit, in the order they should be collected.
+	Amounts []int `json:"amounts"`
+	// Interval is the number of IntervalUnits between instalments.
+	Interval int `json:"interval"`
+	// IntervalUnit is the unit of time between instalments.
+	IntervalUnit IntervalUnit `json:"interval_unit"`
+	// StartDate is the date, in the format YYYY-MM-DD, on which the first instalment should be collected. If left
+	// blank the first instalment is collected as soon as possible.
+	StartDate string `json:"start_date,omitempty"`
+}
+
+// InstalmentScheduleListOptions holds the parameters used to filter the results of ListInstalmentSchedules
+type InstalmentScheduleListOptions struct {
+	ListOptions
+	// Customer restricts the results to schedules for this customer ID
+	Customer string `url:"customer"`
+	// Mandate restricts the results to schedules against this mandate ID
+	Mandate string `url:"mandate"`
+	// Status restricts the results to schedules in this state
+	Status InstalmentScheduleStatus `url:"status"`
+}
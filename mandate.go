@@ -0,0 +1,79 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// MandateStatus describes the state of a Mandate
+type MandateStatus string
+
+const (
+	// MandatePendingCustomerApproval means the mandate has not yet been signed by the second customer
+	MandatePendingCustomerApproval MandateStatus = `pending_customer_approval`
+	// MandatePendingSubmission means the mandate has not yet been submitted to the customer’s bank
+	MandatePendingSubmission MandateStatus = `pending_submission`
+	// MandateSubmitted means the mandate has been submitted to the customer’s bank but has not been processed yet
+	MandateSubmitted MandateStatus = `submitted`
+	// MandateActive means the mandate has been successfully set up by the customer’s bank
+	MandateActive MandateStatus = `active`
+	// MandateFailed means the mandate could not be created
+	MandateFailed MandateStatus = `failed`
+	// MandateCancelled means the mandate has been cancelled
+	MandateCancelled MandateStatus = `cancelled`
+	// MandateExpired means the mandate has expired due to dormancy
+	MandateExpired MandateStatus = `expired`
+)
+
+// Mandate is an authorisation from a customer’s bank account to collect payments via Direct Debit
+type Mandate struct {
+	// ID is a unique identifier, beginning with “MD”.
+	ID string `json:"id,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the mandate was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// NextPossibleChargeDate is the earliest date, in the format YYYY-MM-DD, a newly created payment for this
+	// mandate could be charged.
+	NextPossibleChargeDate string `json:"next_possible_charge_date,omitempty"`
+	// PaymentsRequireApproval is true if payments created against this mandate require approval.
+	PaymentsRequireApproval bool `json:"payments_require_approval,omitempty"`
+	// Reference is a unique reference. Different schemes have different length and character set requirements.
+	// GoCardless will generate a unique reference satisfying the different scheme requirements if this field is
+	// left blank.
+	Reference string `json:"reference,omitempty"`
+	// Scheme is the Direct Debit scheme of the mandate, such as “bacs” or “sepa_core”. If bank account details are
+	// present, they must be suitable for the scheme.
+	Scheme string `json:"scheme,omitempty"`
+	// Status is the current state of the mandate.
+	Status MandateStatus `json:"status,omitempty"`
+	// Links holds the IDs of the resources the mandate is associated with
+	Links *MandateLinks `json:"links,omitempty"`
+}
+
+// MandateLinks holds the IDs of the resources linked to a Mandate
+type MandateLinks struct {
+	// Creditor is the ID of the creditor. Only required if your account manages multiple creditors.
+	Creditor string `json:"creditor,omitempty"`
+	// Customer is the ID of the customer the mandate belongs to.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccount is the ID of the customer bank account the mandate is against.
+	CustomerBankAccount string `json:"customer_bank_account,omitempty"`
+	// NewMandate is the ID of the new mandate, if this mandate has been replaced.
+	NewMandate string `json:"new_mandate,omitempty"`
+}
+
+// MandateListOptions holds the parameters used to filter the results of ListMandates
+type MandateListOptions struct {
+	ListOptions
+	// Creditor restricts the results to mandates for this creditor ID
+	Creditor string `url:"creditor"`
+	// Customer restricts the results to mandates for this customer ID
+	Customer string `url:"customer"`
+	// CustomerBankAccount restricts the results to mandates against this customer bank account ID
+	CustomerBankAccount string `url:"customer_bank_account"`
+	// Reference restricts the results to the mandate with this reference
+	Reference string `url:"reference"`
+	// Status restricts the results to mandates in this state
+	Status MandateStatus `url:"status"`
+}
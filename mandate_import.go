@@ -0,0 +1,92 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// MandateImportStatus describes the state of a MandateImport
+type MandateImportStatus string
+
+const (
+	// MandateImportCreated means entries may be added to the import
+	MandateImportCreated MandateImportStatus = `created`
+	// MandateImportSubmitted means the import has been submitted and is awaiting review by GoCardless
+	MandateImportSubmitted MandateImportStatus = `submitted`
+	// MandateImportCancelled means the import has been cancelled and will not be processed
+	MandateImportCancelled MandateImportStatus = `cancelled`
+	// MandateImportProcessing means the entries of the import are being processed
+	MandateImportProcessing MandateImportStatus = `processing`
+	// MandateImportProcessed means the mandates of the import have been created
+	MandateImportProcessed MandateImportStatus = `processed`
+)
+
+// MandateImport is used to move existing mandates from another provider into GoCardless in bulk. Entries are added to
+// the import, which is then submitted for review before the mandates are created
+type MandateImport struct {
+	// ID is a unique identifier, beginning with “IM”.
+	ID string `json:"id,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the import was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Scheme is the Direct Debit scheme of the mandates being imported, such as “bacs”.
+	Scheme string `json:"scheme,omitempty"`
+	// Status is the current state of the import.
+	Status MandateImportStatus `json:"status,omitempty"`
+	// Links holds the IDs of the resources the import is associated with
+	Links *MandateImportLinks `json:"links,omitempty"`
+}
+
+// MandateImportLinks holds the IDs of the resources linked to a MandateImport
+type MandateImportLinks struct {
+	// Creditor is the ID of the creditor the mandates are imported for. Only required if your account manages
+	// multiple creditors.
+	Creditor string `json:"creditor,omitempty"`
+}
+
+// MandateImportEntry is a single mandate to be created by a MandateImport
+type MandateImportEntry struct {
+	// Amendment holds the details of the mandate at the previous provider, for schemes which require it. Only used on
+	// creation.
+	Amendment *MandateImportEntryAmendment `json:"amendment,omitempty"`
+	// BankAccount holds the bank details of the mandate. Only used on creation.
+	BankAccount *CustomerBankAccount `json:"bank_account,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the entry was added.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Customer holds the details of the customer who owns the mandate. Only used on creation.
+	Customer *Customer `json:"customer,omitempty"`
+	// RecordIdentifier is your own unique reference for the entry, such as the ID of the matching row in your
+	// database. It is returned with the entry once the import has been processed.
+	RecordIdentifier string `json:"record_identifier,omitempty"`
+	// Links holds the IDs of the resources the entry is associated with. The customer, bank account and mandate are
+	// only set once the import has been processed
+	Links *MandateImportEntryLinks `json:"links,omitempty"`
+}
+
+// MandateImportEntryAmendment holds the details of a mandate at the provider it is being imported from
+type MandateImportEntryAmendment struct {
+	// OriginalCreditorID is the creditor identifier used by the previous provider, such as the Bacs service user
+	// number.
+	OriginalCreditorID string `json:"original_creditor_id,omitempty"`
+	// OriginalCreditorName is the creditor name used by the previous provider.
+	OriginalCreditorName string `json:"original_creditor_name,omitempty"`
+	// OriginalMandateReference is the mandate reference used by the previous provider.
+	OriginalMandateReference string `json:"original_mandate_reference,omitempty"`
+}
+
+// MandateImportEntryLinks holds the IDs of the resources linked to a MandateImportEntry
+type MandateImportEntryLinks struct {
+	// Customer is the ID of the customer created for the entry.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccount is the ID of the customer bank account created for the entry.
+	CustomerBankAccount string `json:"customer_bank_account,omitempty"`
+	// Mandate is the ID of the mandate created for the entry.
+	Mandate string `json:"mandate,omitempty"`
+	// MandateImport is the ID of the import the entry belongs to. Required on creation.
+	MandateImport string `json:"mandate_import,omitempty"`
+}
+
+// MandateImportEntryListOptions holds the parameters used to filter the results of ListMandateImportEntries
+type MandateImportEntryListOptions struct {
+	ListOptions
+	// MandateImport is the ID of the import whose entries should be returned. It is required by the API
+	MandateImport string `url:"mandate_import"`
+}
@@ -0,0 +1,77 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// MandatePDFRequest holds the details used to generate a mandate PDF. Either Links.Mandate should be set to produce
+// the PDF of an existing mandate, or the customer and bank details should be supplied to produce a prefilled PDF for
+// a mandate which has not yet been created
+type MandatePDFRequest struct {
+	// AccountHolderName is the name of the account holder, as known by the bank.
+	AccountHolderName string `json:"account_holder_name,omitempty"`
+	// AccountNumber is the bank account number. Alternatively an IBAN can be provided.
+	AccountNumber string `json:"account_number,omitempty"`
+	// AccountType is the type of bank account, required for USD-denominated bank accounts.
+	AccountType string `json:"account_type,omitempty"`
+	// AddressLine1 is the first line of the customer’s address.
+	AddressLine1 string `json:"address_line1,omitempty"`
+	// AddressLine2 is the second line of the customer’s address.
+	AddressLine2 string `json:"address_line2,omitempty"`
+	// AddressLine3 is the third line of the customer’s address.
+	AddressLine3 string `json:"address_line3,omitempty"`
+	// BankCode is the bank code.
+	BankCode string `json:"bank_code,omitempty"`
+	// BIC is the SWIFT BIC. Will be derived automatically if a valid IBAN or local details are provided.
+	BIC string `json:"bic,omitempty"`
+	// BranchCode is the branch code, or sort code in the UK.
+	BranchCode string `json:"branch_code,omitempty"`
+	// City is the city of the customer’s address.
+	City string `json:"city,omitempty"`
+	// CompanyName is the name of the customer’s company, if the customer is a business.
+	CompanyName string `json:"company_name,omitempty"`
+	// CountryCode is the ISO 3166-1 alpha-2 code of the bank account. Required if IBAN is not provided.
+	CountryCode string `json:"country_code,omitempty"`
+	// DanishIdentityNumber is the customer’s CPR or CVR number. Required for Betalingsservice mandates.
+	DanishIdentityNumber string `json:"danish_identity_number,omitempty"`
+	// IBAN is the international bank account number. Alternatively local details can be provided.
+	IBAN string `json:"iban,omitempty"`
+	// MandateReference is the unique reference of the mandate, when it is known.
+	MandateReference string `json:"mandate_reference,omitempty"`
+	// PayerIPAddress is the IP address of the payer, used for fraud checks.
+	PayerIPAddress string `json:"payer_ip_address,omitempty"`
+	// PhoneNumber is the customer’s phone number. Required for Bank of Canada PAD mandates.
+	PhoneNumber string `json:"phone_number,omitempty"`
+	// PostalCode is the postal code of the customer’s address.
+	PostalCode string `json:"postal_code,omitempty"`
+	// Region is the region of the customer’s address, such as a county or state.
+	Region string `json:"region,omitempty"`
+	// Scheme is the Direct Debit scheme to generate the PDF for. If not set it is derived from the bank details.
+	Scheme string `json:"scheme,omitempty"`
+	// SignatureDate, in the format YYYY-MM-DD, is shown as the date the mandate was signed. If blank the PDF will
+	// have space for the customer to write the date.
+	SignatureDate string `json:"signature_date,omitempty"`
+	// SubscriptionAmount is the amount in minor units of the subscription the mandate is for, if any.
+	SubscriptionAmount int `json:"subscription_amount,omitempty"`
+	// SubscriptionFrequency is the frequency of the subscription the mandate is for, if any.
+	SubscriptionFrequency string `json:"subscription_frequency,omitempty"`
+	// SwedishIdentityNumber is the customer’s personnummer or organisationsnummer. Required for Autogiro mandates.
+	SwedishIdentityNumber string `json:"swedish_identity_number,omitempty"`
+	// Links holds the ID of an existing mandate to generate the PDF for
+	Links *MandatePDFLinks `json:"links,omitempty"`
+}
+
+// MandatePDFLinks holds the IDs of the resources linked to a MandatePDFRequest
+type MandatePDFLinks struct {
+	// Mandate is the ID of an existing mandate to build the PDF from. Bank details supplied with the request will be
+	// ignored.
+	Mandate string `json:"mandate,omitempty"`
+}
+
+// MandatePDF is a temporary link to a generated mandate PDF
+type MandatePDF struct {
+	// URL is the address from which the PDF can be downloaded.
+	URL string `json:"url"`
+	// ExpiresAt is the time after which the URL will no longer work.
+	ExpiresAt *time.Time `json:"expires_at"`
+}
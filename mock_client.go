@@ -1,5 +1,9 @@
 package gocardless
 
+import (
+	"context"
+)
+
 // MockClient is provided to assist with your testing. It implements the API interface.
 //
 // Each field in the struct corresponds to the associated method, enabling you to implement whatever functionality is
@@ -12,20 +16,1077 @@ package gocardless
 //     // Calls the GetCustomerFunc value. Note that this will panic if this value has not been set
 //     mock.GetCustomer(`random-id`)
 //
+// The WithContext methods call their own field when it is set, and otherwise fall back to the field of the method
+// without a context, so GetCustomerFunc above is also used by GetCustomerWithContext
 type MockClient struct {
-	CreateCustomerFunc func(*Customer) error
-	GetCustomerFunc    func(string) (*Customer, error)
-	ListCustomerFunc   func() ([]*Customer, error)
+	CreateCustomerFunc            func(*Customer) error
+	CreateCustomerWithContextFunc func(context.Context, *Customer) error
+	GetCustomerFunc               func(string) (*Customer, error)
+	GetCustomerWithContextFunc    func(context.Context, string) (*Customer, error)
+	ListCustomerFunc              func() ([]*Customer, error)
+	ListCustomerWithContextFunc   func(context.Context) ([]*Customer, error)
+	UpdateCustomerFunc            func(*Customer) error
+	UpdateCustomerWithContextFunc func(context.Context, *Customer) error
+	RemoveCustomerFunc            func(string) (*Customer, error)
+	RemoveCustomerWithContextFunc func(context.Context, string) (*Customer, error)
+
+	CreateCustomerBankAccountFunc             func(*CustomerBankAccount) error
+	CreateCustomerBankAccountWithContextFunc  func(context.Context, *CustomerBankAccount) error
+	GetCustomerBankAccountFunc                func(string) (*CustomerBankAccount, error)
+	GetCustomerBankAccountWithContextFunc     func(context.Context, string) (*CustomerBankAccount, error)
+	ListCustomerBankAccountsFunc              func(*CustomerBankAccountListOptions) ([]*CustomerBankAccount, error)
+	ListCustomerBankAccountsWithContextFunc   func(context.Context, *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error)
+	UpdateCustomerBankAccountFunc             func(*CustomerBankAccount) error
+	UpdateCustomerBankAccountWithContextFunc  func(context.Context, *CustomerBankAccount) error
+	DisableCustomerBankAccountFunc            func(string) (*CustomerBankAccount, error)
+	DisableCustomerBankAccountWithContextFunc func(context.Context, string) (*CustomerBankAccount, error)
+
+	CreateMandateFunc               func(*Mandate) error
+	CreateMandateWithContextFunc    func(context.Context, *Mandate) error
+	GetMandateFunc                  func(string) (*Mandate, error)
+	GetMandateWithContextFunc       func(context.Context, string) (*Mandate, error)
+	ListMandatesFunc                func(*MandateListOptions) ([]*Mandate, error)
+	ListMandatesWithContextFunc     func(context.Context, *MandateListOptions) ([]*Mandate, error)
+	UpdateMandateFunc               func(*Mandate) error
+	UpdateMandateWithContextFunc    func(context.Context, *Mandate) error
+	CancelMandateFunc               func(string) (*Mandate, error)
+	CancelMandateWithContextFunc    func(context.Context, string) (*Mandate, error)
+	ReinstateMandateFunc            func(string) (*Mandate, error)
+	ReinstateMandateWithContextFunc func(context.Context, string) (*Mandate, error)
+
+	CreatePaymentFunc            func(*Payment) error
+	CreatePaymentWithContextFunc func(context.Context, *Payment) error
+	GetPaymentFunc               func(string) (*Payment, error)
+	GetPaymentWithContextFunc    func(context.Context, string) (*Payment, error)
+	ListPaymentsFunc             func(*PaymentListOptions) ([]*Payment, error)
+	ListPaymentsWithContextFunc  func(context.Context, *PaymentListOptions) ([]*Payment, error)
+	UpdatePaymentFunc            func(*Payment) error
+	UpdatePaymentWithContextFunc func(context.Context, *Payment) error
+	CancelPaymentFunc            func(string) (*Payment, error)
+	CancelPaymentWithContextFunc func(context.Context, string) (*Payment, error)
+	RetryPaymentFunc             func(string) (*Payment, error)
+	RetryPaymentWithContextFunc  func(context.Context, string) (*Payment, error)
+
+	CreateSubscriptionFunc            func(*Subscription) error
+	CreateSubscriptionWithContextFunc func(context.Context, *Subscription) error
+	GetSubscriptionFunc               func(string) (*Subscription, error)
+	GetSubscriptionWithContextFunc    func(context.Context, string) (*Subscription, error)
+	ListSubscriptionsFunc             func(*SubscriptionListOptions) ([]*Subscription, error)
+	ListSubscriptionsWithContextFunc  func(context.Context, *SubscriptionListOptions) ([]*Subscription, error)
+	UpdateSubscriptionFunc            func(*Subscription) error
+	UpdateSubscriptionWithContextFunc func(context.Context, *Subscription) error
+	PauseSubscriptionFunc             func(string) (*Subscription, error)
+	PauseSubscriptionWithContextFunc  func(context.Context, string) (*Subscription, error)
+	ResumeSubscriptionFunc            func(string) (*Subscription, error)
+	ResumeSubscriptionWithContextFunc func(context.Context, string) (*Subscription, error)
+	CancelSubscriptionFunc            func(string) (*Subscription, error)
+	CancelSubscriptionWithContextFunc func(context.Context, string) (*Subscription, error)
+
+	CreateRefundFunc            func(*Refund) error
+	CreateRefundWithContextFunc func(context.Context, *Refund) error
+	GetRefundFunc               func(string) (*Refund, error)
+	GetRefundWithContextFunc    func(context.Context, string) (*Refund, error)
+	ListRefundsFunc             func(*RefundListOptions) ([]*Refund, error)
+	ListRefundsWithContextFunc  func(context.Context, *RefundListOptions) ([]*Refund, error)
+	UpdateRefundFunc            func(*Refund) error
+	UpdateRefundWithContextFunc func(context.Context, *Refund) error
+
+	GetPayoutFunc                  func(string) (*Payout, error)
+	GetPayoutWithContextFunc       func(context.Context, string) (*Payout, error)
+	ListPayoutsFunc                func(*PayoutListOptions) ([]*Payout, error)
+	ListPayoutsWithContextFunc     func(context.Context, *PayoutListOptions) ([]*Payout, error)
+	ListPayoutItemsFunc            func(*PayoutItemListOptions) ([]*PayoutItem, error)
+	ListPayoutItemsWithContextFunc func(context.Context, *PayoutItemListOptions) ([]*PayoutItem, error)
+
+	CreateCreditorFunc            func(*Creditor) error
+	CreateCreditorWithContextFunc func(context.Context, *Creditor) error
+	GetCreditorFunc               func(string) (*Creditor, error)
+	GetCreditorWithContextFunc    func(context.Context, string) (*Creditor, error)
+	ListCreditorsFunc             func(*CreditorListOptions) ([]*Creditor, error)
+	ListCreditorsWithContextFunc  func(context.Context, *CreditorListOptions) ([]*Creditor, error)
+	UpdateCreditorFunc            func(*Creditor) error
+	UpdateCreditorWithContextFunc func(context.Context, *Creditor) error
+
+	CreateCreditorBankAccountFunc             func(*CreditorBankAccount) error
+	CreateCreditorBankAccountWithContextFunc  func(context.Context, *CreditorBankAccount) error
+	GetCreditorBankAccountFunc                func(string) (*CreditorBankAccount, error)
+	GetCreditorBankAccountWithContextFunc     func(context.Context, string) (*CreditorBankAccount, error)
+	ListCreditorBankAccountsFunc              func(*CreditorBankAccountListOptions) ([]*CreditorBankAccount, error)
+	ListCreditorBankAccountsWithContextFunc   func(context.Context, *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error)
+	DisableCreditorBankAccountFunc            func(string) (*CreditorBankAccount, error)
+	DisableCreditorBankAccountWithContextFunc func(context.Context, string) (*CreditorBankAccount, error)
+
+	GetEventFunc              func(string) (*Event, error)
+	GetEventWithContextFunc   func(context.Context, string) (*Event, error)
+	ListEventsFunc            func(*EventListOptions) ([]*Event, *Linked, error)
+	ListEventsWithContextFunc func(context.Context, *EventListOptions) ([]*Event, *Linked, error)
+
+	CreateRedirectFlowFunc              func(*RedirectFlow) error
+	CreateRedirectFlowWithContextFunc   func(context.Context, *RedirectFlow) error
+	GetRedirectFlowFunc                 func(string) (*RedirectFlow, error)
+	GetRedirectFlowWithContextFunc      func(context.Context, string) (*RedirectFlow, error)
+	CompleteRedirectFlowFunc            func(string, string) (*RedirectFlow, error)
+	CompleteRedirectFlowWithContextFunc func(context.Context, string, string) (*RedirectFlow, error)
+
+	CreateBillingRequestFunc                            func(*BillingRequest) error
+	CreateBillingRequestWithContextFunc                 func(context.Context, *BillingRequest) error
+	GetBillingRequestFunc                               func(string) (*BillingRequest, error)
+	GetBillingRequestWithContextFunc                    func(context.Context, string) (*BillingRequest, error)
+	ListBillingRequestsFunc                             func(*BillingRequestListOptions) ([]*BillingRequest, error)
+	ListBillingRequestsWithContextFunc                  func(context.Context, *BillingRequestListOptions) ([]*BillingRequest, error)
+	CancelBillingRequestFunc                            func(string) (*BillingRequest, error)
+	CancelBillingRequestWithContextFunc                 func(context.Context, string) (*BillingRequest, error)
+	CollectBillingRequestCustomerDetailsFunc            func(string, *CollectCustomerDetailsRequest) (*BillingRequest, error)
+	CollectBillingRequestCustomerDetailsWithContextFunc func(context.Context, string, *CollectCustomerDetailsRequest) (*BillingRequest, error)
+	CollectBillingRequestBankAccountFunc                func(string, *CollectBankAccountRequest) (*BillingRequest, error)
+	CollectBillingRequestBankAccountWithContextFunc     func(context.Context, string, *CollectBankAccountRequest) (*BillingRequest, error)
+	ConfirmBillingRequestPayerDetailsFunc               func(string, *ConfirmPayerDetailsRequest) (*BillingRequest, error)
+	ConfirmBillingRequestPayerDetailsWithContextFunc    func(context.Context, string, *ConfirmPayerDetailsRequest) (*BillingRequest, error)
+	FulfilBillingRequestFunc                            func(string, *FulfilRequest) (*BillingRequest, error)
+	FulfilBillingRequestWithContextFunc                 func(context.Context, string, *FulfilRequest) (*BillingRequest, error)
+	NotifyBillingRequestFunc                            func(string, *NotifyRequest) (*BillingRequest, error)
+	NotifyBillingRequestWithContextFunc                 func(context.Context, string, *NotifyRequest) (*BillingRequest, error)
+	FallbackBillingRequestFunc                          func(string) (*BillingRequest, error)
+	FallbackBillingRequestWithContextFunc               func(context.Context, string) (*BillingRequest, error)
+	ChooseBillingRequestCurrencyFunc                    func(string, *ChooseCurrencyRequest) (*BillingRequest, error)
+	ChooseBillingRequestCurrencyWithContextFunc         func(context.Context, string, *ChooseCurrencyRequest) (*BillingRequest, error)
+	SelectBillingRequestInstitutionFunc                 func(string, *SelectInstitutionRequest) (*BillingRequest, error)
+	SelectBillingRequestInstitutionWithContextFunc      func(context.Context, string, *SelectInstitutionRequest) (*BillingRequest, error)
+
+	CreateBillingRequestFlowFunc                func(*BillingRequestFlow) error
+	CreateBillingRequestFlowWithContextFunc     func(context.Context, *BillingRequestFlow) error
+	InitialiseBillingRequestFlowFunc            func(string) (*BillingRequestFlow, error)
+	InitialiseBillingRequestFlowWithContextFunc func(context.Context, string) (*BillingRequestFlow, error)
+
+	CreateBillingRequestTemplateFunc            func(*BillingRequestTemplate) error
+	CreateBillingRequestTemplateWithContextFunc func(context.Context, *BillingRequestTemplate) error
+	GetBillingRequestTemplateFunc               func(string) (*BillingRequestTemplate, error)
+	GetBillingRequestTemplateWithContextFunc    func(context.Context, string) (*BillingRequestTemplate, error)
+	ListBillingRequestTemplatesFunc             func(*BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error)
+	ListBillingRequestTemplatesWithContextFunc  func(context.Context, *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error)
+	UpdateBillingRequestTemplateFunc            func(*BillingRequestTemplate) error
+	UpdateBillingRequestTemplateWithContextFunc func(context.Context, *BillingRequestTemplate) error
+
+	CreateInstalmentScheduleWithDatesFunc               func(*InstalmentSchedule, []*Instalment) error
+	CreateInstalmentScheduleWithDatesWithContextFunc    func(context.Context, *InstalmentSchedule, []*Instalment) error
+	CreateInstalmentScheduleWithScheduleFunc            func(*InstalmentSchedule, *InstalmentPlan) error
+	CreateInstalmentScheduleWithScheduleWithContextFunc func(context.Context, *InstalmentSchedule, *InstalmentPlan) error
+	GetInstalmentScheduleFunc                           func(string) (*InstalmentSchedule, error)
+	GetInstalmentScheduleWithContextFunc                func(context.Context, string) (*InstalmentSchedule, error)
+	ListInstalmentSchedulesFunc                         func(*InstalmentScheduleListOptions) ([]*InstalmentSchedule, error)
+	ListInstalmentSchedulesWithContextFunc              func(context.Context, *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error)
+	UpdateInstalmentScheduleFunc                        func(*InstalmentSchedule) error
+	UpdateInstalmentScheduleWithContextFunc             func(context.Context, *InstalmentSchedule) error
+	CancelInstalmentScheduleFunc                        func(string) (*InstalmentSchedule, error)
+	CancelInstalmentScheduleWithContextFunc             func(context.Context, string) (*InstalmentSchedule, error)
+
+	CreateMandateImportFunc                 func(*MandateImport) error
+	CreateMandateImportWithContextFunc      func(context.Context, *MandateImport) error
+	GetMandateImportFunc                    func(string) (*MandateImport, error)
+	GetMandateImportWithContextFunc         func(context.Context, string) (*MandateImport, error)
+	SubmitMandateImportFunc                 func(string) (*MandateImport, error)
+	SubmitMandateImportWithContextFunc      func(context.Context, string) (*MandateImport, error)
+	CancelMandateImportFunc                 func(string) (*MandateImport, error)
+	CancelMandateImportWithContextFunc      func(context.Context, string) (*MandateImport, error)
+	AddMandateImportEntryFunc               func(*MandateImportEntry) error
+	AddMandateImportEntryWithContextFunc    func(context.Context, *MandateImportEntry) error
+	ListMandateImportEntriesFunc            func(*MandateImportEntryListOptions) ([]*MandateImportEntry, error)
+	ListMandateImportEntriesWithContextFunc func(context.Context, *MandateImportEntryListOptions) ([]*MandateImportEntry, error)
+
+	LookupBankDetailsFunc            func(*BankDetails) (*BankDetailsLookup, error)
+	LookupBankDetailsWithContextFunc func(context.Context, *BankDetails) (*BankDetailsLookup, error)
+
+	CreateMandatePDFFunc            func(*MandatePDFRequest, string) (*MandatePDF, error)
+	CreateMandatePDFWithContextFunc func(context.Context, *MandatePDFRequest, string) (*MandatePDF, error)
 }
 
 func (mock *MockClient) CreateCustomer(c *Customer) error {
 	return mock.CreateCustomerFunc(c)
 }
 
+func (mock *MockClient) CreateCustomerWithContext(ctx context.Context, c *Customer) error {
+	if mock.CreateCustomerWithContextFunc == nil {
+		return mock.CreateCustomerFunc(c)
+	}
+	return mock.CreateCustomerWithContextFunc(ctx, c)
+}
+
 func (mock *MockClient) GetCustomer(id string) (*Customer, error) {
 	return mock.GetCustomerFunc(id)
 }
 
+func (mock *MockClient) GetCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
+	if mock.GetCustomerWithContextFunc == nil {
+		return mock.GetCustomerFunc(id)
+	}
+	return mock.GetCustomerWithContextFunc(ctx, id)
+}
+
 func (mock *MockClient) ListCustomer() ([]*Customer, error) {
 	return mock.ListCustomerFunc()
 }
+
+func (mock *MockClient) ListCustomerWithContext(ctx context.Context) ([]*Customer, error) {
+	if mock.ListCustomerWithContextFunc == nil {
+		return mock.ListCustomerFunc()
+	}
+	return mock.ListCustomerWithContextFunc(ctx)
+}
+
+func (mock *MockClient) UpdateCustomer(c *Customer) error {
+	return mock.UpdateCustomerFunc(c)
+}
+
+func (mock *MockClient) UpdateCustomerWithContext(ctx context.Context, c *Customer) error {
+	if mock.UpdateCustomerWithContextFunc == nil {
+		return mock.UpdateCustomerFunc(c)
+	}
+	return mock.UpdateCustomerWithContextFunc(ctx, c)
+}
+
+func (mock *MockClient) RemoveCustomer(id string) (*Customer, error) {
+	return mock.RemoveCustomerFunc(id)
+}
+
+func (mock *MockClient) RemoveCustomerWithContext(ctx context.Context, id string) (*Customer, error) {
+	if mock.RemoveCustomerWithContextFunc == nil {
+		return mock.RemoveCustomerFunc(id)
+	}
+	return mock.RemoveCustomerWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateCustomerBankAccount(account *CustomerBankAccount) error {
+	return mock.CreateCustomerBankAccountFunc(account)
+}
+
+func (mock *MockClient) CreateCustomerBankAccountWithContext(ctx context.Context, account *CustomerBankAccount) error {
+	if mock.CreateCustomerBankAccountWithContextFunc == nil {
+		return mock.CreateCustomerBankAccountFunc(account)
+	}
+	return mock.CreateCustomerBankAccountWithContextFunc(ctx, account)
+}
+
+func (mock *MockClient) GetCustomerBankAccount(id string) (*CustomerBankAccount, error) {
+	return mock.GetCustomerBankAccountFunc(id)
+}
+
+func (mock *MockClient) GetCustomerBankAccountWithContext(ctx context.Context, id string) (*CustomerBankAccount, error) {
+	if mock.GetCustomerBankAccountWithContextFunc == nil {
+		return mock.GetCustomerBankAccountFunc(id)
+	}
+	return mock.GetCustomerBankAccountWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListCustomerBankAccounts(options *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error) {
+	return mock.ListCustomerBankAccountsFunc(options)
+}
+
+func (mock *MockClient) ListCustomerBankAccountsWithContext(ctx context.Context, options *CustomerBankAccountListOptions) ([]*CustomerBankAccount, error) {
+	if mock.ListCustomerBankAccountsWithContextFunc == nil {
+		return mock.ListCustomerBankAccountsFunc(options)
+	}
+	return mock.ListCustomerBankAccountsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateCustomerBankAccount(account *CustomerBankAccount) error {
+	return mock.UpdateCustomerBankAccountFunc(account)
+}
+
+func (mock *MockClient) UpdateCustomerBankAccountWithContext(ctx context.Context, account *CustomerBankAccount) error {
+	if mock.UpdateCustomerBankAccountWithContextFunc == nil {
+		return mock.UpdateCustomerBankAccountFunc(account)
+	}
+	return mock.UpdateCustomerBankAccountWithContextFunc(ctx, account)
+}
+
+func (mock *MockClient) DisableCustomerBankAccount(id string) (*CustomerBankAccount, error) {
+	return mock.DisableCustomerBankAccountFunc(id)
+}
+
+func (mock *MockClient) DisableCustomerBankAccountWithContext(ctx context.Context, id string) (*CustomerBankAccount, error) {
+	if mock.DisableCustomerBankAccountWithContextFunc == nil {
+		return mock.DisableCustomerBankAccountFunc(id)
+	}
+	return mock.DisableCustomerBankAccountWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateMandate(mandate *Mandate) error {
+	return mock.CreateMandateFunc(mandate)
+}
+
+func (mock *MockClient) CreateMandateWithContext(ctx context.Context, mandate *Mandate) error {
+	if mock.CreateMandateWithContextFunc == nil {
+		return mock.CreateMandateFunc(mandate)
+	}
+	return mock.CreateMandateWithContextFunc(ctx, mandate)
+}
+
+func (mock *MockClient) GetMandate(id string) (*Mandate, error) {
+	return mock.GetMandateFunc(id)
+}
+
+func (mock *MockClient) GetMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	if mock.GetMandateWithContextFunc == nil {
+		return mock.GetMandateFunc(id)
+	}
+	return mock.GetMandateWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListMandates(options *MandateListOptions) ([]*Mandate, error) {
+	return mock.ListMandatesFunc(options)
+}
+
+func (mock *MockClient) ListMandatesWithContext(ctx context.Context, options *MandateListOptions) ([]*Mandate, error) {
+	if mock.ListMandatesWithContextFunc == nil {
+		return mock.ListMandatesFunc(options)
+	}
+	return mock.ListMandatesWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateMandate(mandate *Mandate) error {
+	return mock.UpdateMandateFunc(mandate)
+}
+
+func (mock *MockClient) UpdateMandateWithContext(ctx context.Context, mandate *Mandate) error {
+	if mock.UpdateMandateWithContextFunc == nil {
+		return mock.UpdateMandateFunc(mandate)
+	}
+	return mock.UpdateMandateWithContextFunc(ctx, mandate)
+}
+
+func (mock *MockClient) CancelMandate(id string) (*Mandate, error) {
+	return mock.CancelMandateFunc(id)
+}
+
+func (mock *MockClient) CancelMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	if mock.CancelMandateWithContextFunc == nil {
+		return mock.CancelMandateFunc(id)
+	}
+	return mock.CancelMandateWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ReinstateMandate(id string) (*Mandate, error) {
+	return mock.ReinstateMandateFunc(id)
+}
+
+func (mock *MockClient) ReinstateMandateWithContext(ctx context.Context, id string) (*Mandate, error) {
+	if mock.ReinstateMandateWithContextFunc == nil {
+		return mock.ReinstateMandateFunc(id)
+	}
+	return mock.ReinstateMandateWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreatePayment(payment *Payment) error {
+	return mock.CreatePaymentFunc(payment)
+}
+
+func (mock *MockClient) CreatePaymentWithContext(ctx context.Context, payment *Payment) error {
+	if mock.CreatePaymentWithContextFunc == nil {
+		return mock.CreatePaymentFunc(payment)
+	}
+	return mock.CreatePaymentWithContextFunc(ctx, payment)
+}
+
+func (mock *MockClient) GetPayment(id string) (*Payment, error) {
+	return mock.GetPaymentFunc(id)
+}
+
+func (mock *MockClient) GetPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	if mock.GetPaymentWithContextFunc == nil {
+		return mock.GetPaymentFunc(id)
+	}
+	return mock.GetPaymentWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListPayments(options *PaymentListOptions) ([]*Payment, error) {
+	return mock.ListPaymentsFunc(options)
+}
+
+func (mock *MockClient) ListPaymentsWithContext(ctx context.Context, options *PaymentListOptions) ([]*Payment, error) {
+	if mock.ListPaymentsWithContextFunc == nil {
+		return mock.ListPaymentsFunc(options)
+	}
+	return mock.ListPaymentsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdatePayment(payment *Payment) error {
+	return mock.UpdatePaymentFunc(payment)
+}
+
+func (mock *MockClient) UpdatePaymentWithContext(ctx context.Context, payment *Payment) error {
+	if mock.UpdatePaymentWithContextFunc == nil {
+		return mock.UpdatePaymentFunc(payment)
+	}
+	return mock.UpdatePaymentWithContextFunc(ctx, payment)
+}
+
+func (mock *MockClient) CancelPayment(id string) (*Payment, error) {
+	return mock.CancelPaymentFunc(id)
+}
+
+func (mock *MockClient) CancelPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	if mock.CancelPaymentWithContextFunc == nil {
+		return mock.CancelPaymentFunc(id)
+	}
+	return mock.CancelPaymentWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) RetryPayment(id string) (*Payment, error) {
+	return mock.RetryPaymentFunc(id)
+}
+
+func (mock *MockClient) RetryPaymentWithContext(ctx context.Context, id string) (*Payment, error) {
+	if mock.RetryPaymentWithContextFunc == nil {
+		return mock.RetryPaymentFunc(id)
+	}
+	return mock.RetryPaymentWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateSubscription(subscription *Subscription) error {
+	return mock.CreateSubscriptionFunc(subscription)
+}
+
+func (mock *MockClient) CreateSubscriptionWithContext(ctx context.Context, subscription *Subscription) error {
+	if mock.CreateSubscriptionWithContextFunc == nil {
+		return mock.CreateSubscriptionFunc(subscription)
+	}
+	return mock.CreateSubscriptionWithContextFunc(ctx, subscription)
+}
+
+func (mock *MockClient) GetSubscription(id string) (*Subscription, error) {
+	return mock.GetSubscriptionFunc(id)
+}
+
+func (mock *MockClient) GetSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	if mock.GetSubscriptionWithContextFunc == nil {
+		return mock.GetSubscriptionFunc(id)
+	}
+	return mock.GetSubscriptionWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListSubscriptions(options *SubscriptionListOptions) ([]*Subscription, error) {
+	return mock.ListSubscriptionsFunc(options)
+}
+
+func (mock *MockClient) ListSubscriptionsWithContext(ctx context.Context, options *SubscriptionListOptions) ([]*Subscription, error) {
+	if mock.ListSubscriptionsWithContextFunc == nil {
+		return mock.ListSubscriptionsFunc(options)
+	}
+	return mock.ListSubscriptionsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateSubscription(subscription *Subscription) error {
+	return mock.UpdateSubscriptionFunc(subscription)
+}
+
+func (mock *MockClient) UpdateSubscriptionWithContext(ctx context.Context, subscription *Subscription) error {
+	if mock.UpdateSubscriptionWithContextFunc == nil {
+		return mock.UpdateSubscriptionFunc(subscription)
+	}
+	return mock.UpdateSubscriptionWithContextFunc(ctx, subscription)
+}
+
+func (mock *MockClient) PauseSubscription(id string) (*Subscription, error) {
+	return mock.PauseSubscriptionFunc(id)
+}
+
+func (mock *MockClient) PauseSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	if mock.PauseSubscriptionWithContextFunc == nil {
+		return mock.PauseSubscriptionFunc(id)
+	}
+	return mock.PauseSubscriptionWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ResumeSubscription(id string) (*Subscription, error) {
+	return mock.ResumeSubscriptionFunc(id)
+}
+
+func (mock *MockClient) ResumeSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	if mock.ResumeSubscriptionWithContextFunc == nil {
+		return mock.ResumeSubscriptionFunc(id)
+	}
+	return mock.ResumeSubscriptionWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CancelSubscription(id string) (*Subscription, error) {
+	return mock.CancelSubscriptionFunc(id)
+}
+
+func (mock *MockClient) CancelSubscriptionWithContext(ctx context.Context, id string) (*Subscription, error) {
+	if mock.CancelSubscriptionWithContextFunc == nil {
+		return mock.CancelSubscriptionFunc(id)
+	}
+	return mock.CancelSubscriptionWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateRefund(refund *Refund) error {
+	return mock.CreateRefundFunc(refund)
+}
+
+func (mock *MockClient) CreateRefundWithContext(ctx context.Context, refund *Refund) error {
+	if mock.CreateRefundWithContextFunc == nil {
+		return mock.CreateRefundFunc(refund)
+	}
+	return mock.CreateRefundWithContextFunc(ctx, refund)
+}
+
+func (mock *MockClient) GetRefund(id string) (*Refund, error) {
+	return mock.GetRefundFunc(id)
+}
+
+func (mock *MockClient) GetRefundWithContext(ctx context.Context, id string) (*Refund, error) {
+	if mock.GetRefundWithContextFunc == nil {
+		return mock.GetRefundFunc(id)
+	}
+	return mock.GetRefundWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListRefunds(options *RefundListOptions) ([]*Refund, error) {
+	return mock.ListRefundsFunc(options)
+}
+
+func (mock *MockClient) ListRefundsWithContext(ctx context.Context, options *RefundListOptions) ([]*Refund, error) {
+	if mock.ListRefundsWithContextFunc == nil {
+		return mock.ListRefundsFunc(options)
+	}
+	return mock.ListRefundsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateRefund(refund *Refund) error {
+	return mock.UpdateRefundFunc(refund)
+}
+
+func (mock *MockClient) UpdateRefundWithContext(ctx context.Context, refund *Refund) error {
+	if mock.UpdateRefundWithContextFunc == nil {
+		return mock.UpdateRefundFunc(refund)
+	}
+	return mock.UpdateRefundWithContextFunc(ctx, refund)
+}
+
+func (mock *MockClient) GetPayout(id string) (*Payout, error) {
+	return mock.GetPayoutFunc(id)
+}
+
+func (mock *MockClient) GetPayoutWithContext(ctx context.Context, id string) (*Payout, error) {
+	if mock.GetPayoutWithContextFunc == nil {
+		return mock.GetPayoutFunc(id)
+	}
+	return mock.GetPayoutWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListPayouts(options *PayoutListOptions) ([]*Payout, error) {
+	return mock.ListPayoutsFunc(options)
+}
+
+func (mock *MockClient) ListPayoutsWithContext(ctx context.Context, options *PayoutListOptions) ([]*Payout, error) {
+	if mock.ListPayoutsWithContextFunc == nil {
+		return mock.ListPayoutsFunc(options)
+	}
+	return mock.ListPayoutsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) ListPayoutItems(options *PayoutItemListOptions) ([]*PayoutItem, error) {
+	return mock.ListPayoutItemsFunc(options)
+}
+
+func (mock *MockClient) ListPayoutItemsWithContext(ctx context.Context, options *PayoutItemListOptions) ([]*PayoutItem, error) {
+	if mock.ListPayoutItemsWithContextFunc == nil {
+		return mock.ListPayoutItemsFunc(options)
+	}
+	return mock.ListPayoutItemsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) CreateCreditor(creditor *Creditor) error {
+	return mock.CreateCreditorFunc(creditor)
+}
+
+func (mock *MockClient) CreateCreditorWithContext(ctx context.Context, creditor *Creditor) error {
+	if mock.CreateCreditorWithContextFunc == nil {
+		return mock.CreateCreditorFunc(creditor)
+	}
+	return mock.CreateCreditorWithContextFunc(ctx, creditor)
+}
+
+func (mock *MockClient) GetCreditor(id string) (*Creditor, error) {
+	return mock.GetCreditorFunc(id)
+}
+
+func (mock *MockClient) GetCreditorWithContext(ctx context.Context, id string) (*Creditor, error) {
+	if mock.GetCreditorWithContextFunc == nil {
+		return mock.GetCreditorFunc(id)
+	}
+	return mock.GetCreditorWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListCreditors(options *CreditorListOptions) ([]*Creditor, error) {
+	return mock.ListCreditorsFunc(options)
+}
+
+func (mock *MockClient) ListCreditorsWithContext(ctx context.Context, options *CreditorListOptions) ([]*Creditor, error) {
+	if mock.ListCreditorsWithContextFunc == nil {
+		return mock.ListCreditorsFunc(options)
+	}
+	return mock.ListCreditorsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateCreditor(creditor *Creditor) error {
+	return mock.UpdateCreditorFunc(creditor)
+}
+
+func (mock *MockClient) UpdateCreditorWithContext(ctx context.Context, creditor *Creditor) error {
+	if mock.UpdateCreditorWithContextFunc == nil {
+		return mock.UpdateCreditorFunc(creditor)
+	}
+	return mock.UpdateCreditorWithContextFunc(ctx, creditor)
+}
+
+func (mock *MockClient) CreateCreditorBankAccount(creditorBankAccount *CreditorBankAccount) error {
+	return mock.CreateCreditorBankAccountFunc(creditorBankAccount)
+}
+
+func (mock *MockClient) CreateCreditorBankAccountWithContext(ctx context.Context, creditorBankAccount *CreditorBankAccount) error {
+	if mock.CreateCreditorBankAccountWithContextFunc == nil {
+		return mock.CreateCreditorBankAccountFunc(creditorBankAccount)
+	}
+	return mock.CreateCreditorBankAccountWithContextFunc(ctx, creditorBankAccount)
+}
+
+func (mock *MockClient) GetCreditorBankAccount(id string) (*CreditorBankAccount, error) {
+	return mock.GetCreditorBankAccountFunc(id)
+}
+
+func (mock *MockClient) GetCreditorBankAccountWithContext(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	if mock.GetCreditorBankAccountWithContextFunc == nil {
+		return mock.GetCreditorBankAccountFunc(id)
+	}
+	return mock.GetCreditorBankAccountWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListCreditorBankAccounts(options *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error) {
+	return mock.ListCreditorBankAccountsFunc(options)
+}
+
+func (mock *MockClient) ListCreditorBankAccountsWithContext(ctx context.Context, options *CreditorBankAccountListOptions) ([]*CreditorBankAccount, error) {
+	if mock.ListCreditorBankAccountsWithContextFunc == nil {
+		return mock.ListCreditorBankAccountsFunc(options)
+	}
+	return mock.ListCreditorBankAccountsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) DisableCreditorBankAccount(id string) (*CreditorBankAccount, error) {
+	return mock.DisableCreditorBankAccountFunc(id)
+}
+
+func (mock *MockClient) DisableCreditorBankAccountWithContext(ctx context.Context, id string) (*CreditorBankAccount, error) {
+	if mock.DisableCreditorBankAccountWithContextFunc == nil {
+		return mock.DisableCreditorBankAccountFunc(id)
+	}
+	return mock.DisableCreditorBankAccountWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) GetEvent(id string) (*Event, error) {
+	return mock.GetEventFunc(id)
+}
+
+func (mock *MockClient) GetEventWithContext(ctx context.Context, id string) (*Event, error) {
+	if mock.GetEventWithContextFunc == nil {
+		return mock.GetEventFunc(id)
+	}
+	return mock.GetEventWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListEvents(options *EventListOptions) ([]*Event, *Linked, error) {
+	return mock.ListEventsFunc(options)
+}
+
+func (mock *MockClient) ListEventsWithContext(ctx context.Context, options *EventListOptions) ([]*Event, *Linked, error) {
+	if mock.ListEventsWithContextFunc == nil {
+		return mock.ListEventsFunc(options)
+	}
+	return mock.ListEventsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) CreateRedirectFlow(redirectFlow *RedirectFlow) error {
+	return mock.CreateRedirectFlowFunc(redirectFlow)
+}
+
+func (mock *MockClient) CreateRedirectFlowWithContext(ctx context.Context, redirectFlow *RedirectFlow) error {
+	if mock.CreateRedirectFlowWithContextFunc == nil {
+		return mock.CreateRedirectFlowFunc(redirectFlow)
+	}
+	return mock.CreateRedirectFlowWithContextFunc(ctx, redirectFlow)
+}
+
+func (mock *MockClient) GetRedirectFlow(id string) (*RedirectFlow, error) {
+	return mock.GetRedirectFlowFunc(id)
+}
+
+func (mock *MockClient) GetRedirectFlowWithContext(ctx context.Context, id string) (*RedirectFlow, error) {
+	if mock.GetRedirectFlowWithContextFunc == nil {
+		return mock.GetRedirectFlowFunc(id)
+	}
+	return mock.GetRedirectFlowWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CompleteRedirectFlow(id, sessionToken string) (*RedirectFlow, error) {
+	return mock.CompleteRedirectFlowFunc(id, sessionToken)
+}
+
+func (mock *MockClient) CompleteRedirectFlowWithContext(ctx context.Context, id, sessionToken string) (*RedirectFlow, error) {
+	if mock.CompleteRedirectFlowWithContextFunc == nil {
+		return mock.CompleteRedirectFlowFunc(id, sessionToken)
+	}
+	return mock.CompleteRedirectFlowWithContextFunc(ctx, id, sessionToken)
+}
+
+func (mock *MockClient) CreateBillingRequest(billingRequest *BillingRequest) error {
+	return mock.CreateBillingRequestFunc(billingRequest)
+}
+
+func (mock *MockClient) CreateBillingRequestWithContext(ctx context.Context, billingRequest *BillingRequest) error {
+	if mock.CreateBillingRequestWithContextFunc == nil {
+		return mock.CreateBillingRequestFunc(billingRequest)
+	}
+	return mock.CreateBillingRequestWithContextFunc(ctx, billingRequest)
+}
+
+func (mock *MockClient) GetBillingRequest(id string) (*BillingRequest, error) {
+	return mock.GetBillingRequestFunc(id)
+}
+
+func (mock *MockClient) GetBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	if mock.GetBillingRequestWithContextFunc == nil {
+		return mock.GetBillingRequestFunc(id)
+	}
+	return mock.GetBillingRequestWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListBillingRequests(options *BillingRequestListOptions) ([]*BillingRequest, error) {
+	return mock.ListBillingRequestsFunc(options)
+}
+
+func (mock *MockClient) ListBillingRequestsWithContext(ctx context.Context, options *BillingRequestListOptions) ([]*BillingRequest, error) {
+	if mock.ListBillingRequestsWithContextFunc == nil {
+		return mock.ListBillingRequestsFunc(options)
+	}
+	return mock.ListBillingRequestsWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) CancelBillingRequest(id string) (*BillingRequest, error) {
+	return mock.CancelBillingRequestFunc(id)
+}
+
+func (mock *MockClient) CancelBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	if mock.CancelBillingRequestWithContextFunc == nil {
+		return mock.CancelBillingRequestFunc(id)
+	}
+	return mock.CancelBillingRequestWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CollectBillingRequestCustomerDetails(id string, details *CollectCustomerDetailsRequest) (*BillingRequest, error) {
+	return mock.CollectBillingRequestCustomerDetailsFunc(id, details)
+}
+
+func (mock *MockClient) CollectBillingRequestCustomerDetailsWithContext(ctx context.Context, id string, details *CollectCustomerDetailsRequest) (*BillingRequest, error) {
+	if mock.CollectBillingRequestCustomerDetailsWithContextFunc == nil {
+		return mock.CollectBillingRequestCustomerDetailsFunc(id, details)
+	}
+	return mock.CollectBillingRequestCustomerDetailsWithContextFunc(ctx, id, details)
+}
+
+func (mock *MockClient) CollectBillingRequestBankAccount(id string, account *CollectBankAccountRequest) (*BillingRequest, error) {
+	return mock.CollectBillingRequestBankAccountFunc(id, account)
+}
+
+func (mock *MockClient) CollectBillingRequestBankAccountWithContext(ctx context.Context, id string, account *CollectBankAccountRequest) (*BillingRequest, error) {
+	if mock.CollectBillingRequestBankAccountWithContextFunc == nil {
+		return mock.CollectBillingRequestBankAccountFunc(id, account)
+	}
+	return mock.CollectBillingRequestBankAccountWithContextFunc(ctx, id, account)
+}
+
+func (mock *MockClient) ConfirmBillingRequestPayerDetails(id string, confirmation *ConfirmPayerDetailsRequest) (*BillingRequest, error) {
+	return mock.ConfirmBillingRequestPayerDetailsFunc(id, confirmation)
+}
+
+func (mock *MockClient) ConfirmBillingRequestPayerDetailsWithContext(ctx context.Context, id string, confirmation *ConfirmPayerDetailsRequest) (*BillingRequest, error) {
+	if mock.ConfirmBillingRequestPayerDetailsWithContextFunc == nil {
+		return mock.ConfirmBillingRequestPayerDetailsFunc(id, confirmation)
+	}
+	return mock.ConfirmBillingRequestPayerDetailsWithContextFunc(ctx, id, confirmation)
+}
+
+func (mock *MockClient) FulfilBillingRequest(id string, fulfilment *FulfilRequest) (*BillingRequest, error) {
+	return mock.FulfilBillingRequestFunc(id, fulfilment)
+}
+
+func (mock *MockClient) FulfilBillingRequestWithContext(ctx context.Context, id string, fulfilment *FulfilRequest) (*BillingRequest, error) {
+	if mock.FulfilBillingRequestWithContextFunc == nil {
+		return mock.FulfilBillingRequestFunc(id, fulfilment)
+	}
+	return mock.FulfilBillingRequestWithContextFunc(ctx, id, fulfilment)
+}
+
+func (mock *MockClient) NotifyBillingRequest(id string, notification *NotifyRequest) (*BillingRequest, error) {
+	return mock.NotifyBillingRequestFunc(id, notification)
+}
+
+func (mock *MockClient) NotifyBillingRequestWithContext(ctx context.Context, id string, notification *NotifyRequest) (*BillingRequest, error) {
+	if mock.NotifyBillingRequestWithContextFunc == nil {
+		return mock.NotifyBillingRequestFunc(id, notification)
+	}
+	return mock.NotifyBillingRequestWithContextFunc(ctx, id, notification)
+}
+
+func (mock *MockClient) FallbackBillingRequest(id string) (*BillingRequest, error) {
+	return mock.FallbackBillingRequestFunc(id)
+}
+
+func (mock *MockClient) FallbackBillingRequestWithContext(ctx context.Context, id string) (*BillingRequest, error) {
+	if mock.FallbackBillingRequestWithContextFunc == nil {
+		return mock.FallbackBillingRequestFunc(id)
+	}
+	return mock.FallbackBillingRequestWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ChooseBillingRequestCurrency(id string, choice *ChooseCurrencyRequest) (*BillingRequest, error) {
+	return mock.ChooseBillingRequestCurrencyFunc(id, choice)
+}
+
+func (mock *MockClient) ChooseBillingRequestCurrencyWithContext(ctx context.Context, id string, choice *ChooseCurrencyRequest) (*BillingRequest, error) {
+	if mock.ChooseBillingRequestCurrencyWithContextFunc == nil {
+		return mock.ChooseBillingRequestCurrencyFunc(id, choice)
+	}
+	return mock.ChooseBillingRequestCurrencyWithContextFunc(ctx, id, choice)
+}
+
+func (mock *MockClient) SelectBillingRequestInstitution(id string, selection *SelectInstitutionRequest) (*BillingRequest, error) {
+	return mock.SelectBillingRequestInstitutionFunc(id, selection)
+}
+
+func (mock *MockClient) SelectBillingRequestInstitutionWithContext(ctx context.Context, id string, selection *SelectInstitutionRequest) (*BillingRequest, error) {
+	if mock.SelectBillingRequestInstitutionWithContextFunc == nil {
+		return mock.SelectBillingRequestInstitutionFunc(id, selection)
+	}
+	return mock.SelectBillingRequestInstitutionWithContextFunc(ctx, id, selection)
+}
+
+func (mock *MockClient) CreateBillingRequestFlow(flow *BillingRequestFlow) error {
+	return mock.CreateBillingRequestFlowFunc(flow)
+}
+
+func (mock *MockClient) CreateBillingRequestFlowWithContext(ctx context.Context, flow *BillingRequestFlow) error {
+	if mock.CreateBillingRequestFlowWithContextFunc == nil {
+		return mock.CreateBillingRequestFlowFunc(flow)
+	}
+	return mock.CreateBillingRequestFlowWithContextFunc(ctx, flow)
+}
+
+func (mock *MockClient) InitialiseBillingRequestFlow(id string) (*BillingRequestFlow, error) {
+	return mock.InitialiseBillingRequestFlowFunc(id)
+}
+
+func (mock *MockClient) InitialiseBillingRequestFlowWithContext(ctx context.Context, id string) (*BillingRequestFlow, error) {
+	if mock.InitialiseBillingRequestFlowWithContextFunc == nil {
+		return mock.InitialiseBillingRequestFlowFunc(id)
+	}
+	return mock.InitialiseBillingRequestFlowWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateBillingRequestTemplate(template *BillingRequestTemplate) error {
+	return mock.CreateBillingRequestTemplateFunc(template)
+}
+
+func (mock *MockClient) CreateBillingRequestTemplateWithContext(ctx context.Context, template *BillingRequestTemplate) error {
+	if mock.CreateBillingRequestTemplateWithContextFunc == nil {
+		return mock.CreateBillingRequestTemplateFunc(template)
+	}
+	return mock.CreateBillingRequestTemplateWithContextFunc(ctx, template)
+}
+
+func (mock *MockClient) GetBillingRequestTemplate(id string) (*BillingRequestTemplate, error) {
+	return mock.GetBillingRequestTemplateFunc(id)
+}
+
+func (mock *MockClient) GetBillingRequestTemplateWithContext(ctx context.Context, id string) (*BillingRequestTemplate, error) {
+	if mock.GetBillingRequestTemplateWithContextFunc == nil {
+		return mock.GetBillingRequestTemplateFunc(id)
+	}
+	return mock.GetBillingRequestTemplateWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListBillingRequestTemplates(options *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error) {
+	return mock.ListBillingRequestTemplatesFunc(options)
+}
+
+func (mock *MockClient) ListBillingRequestTemplatesWithContext(ctx context.Context, options *BillingRequestTemplateListOptions) ([]*BillingRequestTemplate, error) {
+	if mock.ListBillingRequestTemplatesWithContextFunc == nil {
+		return mock.ListBillingRequestTemplatesFunc(options)
+	}
+	return mock.ListBillingRequestTemplatesWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateBillingRequestTemplate(template *BillingRequestTemplate) error {
+	return mock.UpdateBillingRequestTemplateFunc(template)
+}
+
+func (mock *MockClient) UpdateBillingRequestTemplateWithContext(ctx context.Context, template *BillingRequestTemplate) error {
+	if mock.UpdateBillingRequestTemplateWithContextFunc == nil {
+		return mock.UpdateBillingRequestTemplateFunc(template)
+	}
+	return mock.UpdateBillingRequestTemplateWithContextFunc(ctx, template)
+}
+
+func (mock *MockClient) CreateInstalmentScheduleWithDates(schedule *InstalmentSchedule, instalments []*Instalment) error {
+	return mock.CreateInstalmentScheduleWithDatesFunc(schedule, instalments)
+}
+
+func (mock *MockClient) CreateInstalmentScheduleWithDatesWithContext(ctx context.Context, schedule *InstalmentSchedule, instalments []*Instalment) error {
+	if mock.CreateInstalmentScheduleWithDatesWithContextFunc == nil {
+		return mock.CreateInstalmentScheduleWithDatesFunc(schedule, instalments)
+	}
+	return mock.CreateInstalmentScheduleWithDatesWithContextFunc(ctx, schedule, instalments)
+}
+
+func (mock *MockClient) CreateInstalmentScheduleWithSchedule(schedule *InstalmentSchedule, plan *InstalmentPlan) error {
+	return mock.CreateInstalmentScheduleWithScheduleFunc(schedule, plan)
+}
+
+func (mock *MockClient) CreateInstalmentScheduleWithScheduleWithContext(ctx context.Context, schedule *InstalmentSchedule, plan *InstalmentPlan) error {
+	if mock.CreateInstalmentScheduleWithScheduleWithContextFunc == nil {
+		return mock.CreateInstalmentScheduleWithScheduleFunc(schedule, plan)
+	}
+	return mock.CreateInstalmentScheduleWithScheduleWithContextFunc(ctx, schedule, plan)
+}
+
+func (mock *MockClient) GetInstalmentSchedule(id string) (*InstalmentSchedule, error) {
+	return mock.GetInstalmentScheduleFunc(id)
+}
+
+func (mock *MockClient) GetInstalmentScheduleWithContext(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	if mock.GetInstalmentScheduleWithContextFunc == nil {
+		return mock.GetInstalmentScheduleFunc(id)
+	}
+	return mock.GetInstalmentScheduleWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) ListInstalmentSchedules(options *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error) {
+	return mock.ListInstalmentSchedulesFunc(options)
+}
+
+func (mock *MockClient) ListInstalmentSchedulesWithContext(ctx context.Context, options *InstalmentScheduleListOptions) ([]*InstalmentSchedule, error) {
+	if mock.ListInstalmentSchedulesWithContextFunc == nil {
+		return mock.ListInstalmentSchedulesFunc(options)
+	}
+	return mock.ListInstalmentSchedulesWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) UpdateInstalmentSchedule(schedule *InstalmentSchedule) error {
+	return mock.UpdateInstalmentScheduleFunc(schedule)
+}
+
+func (mock *MockClient) UpdateInstalmentScheduleWithContext(ctx context.Context, schedule *InstalmentSchedule) error {
+	if mock.UpdateInstalmentScheduleWithContextFunc == nil {
+		return mock.UpdateInstalmentScheduleFunc(schedule)
+	}
+	return mock.UpdateInstalmentScheduleWithContextFunc(ctx, schedule)
+}
+
+func (mock *MockClient) CancelInstalmentSchedule(id string) (*InstalmentSchedule, error) {
+	return mock.CancelInstalmentScheduleFunc(id)
+}
+
+func (mock *MockClient) CancelInstalmentScheduleWithContext(ctx context.Context, id string) (*InstalmentSchedule, error) {
+	if mock.CancelInstalmentScheduleWithContextFunc == nil {
+		return mock.CancelInstalmentScheduleFunc(id)
+	}
+	return mock.CancelInstalmentScheduleWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CreateMandateImport(mandateImport *MandateImport) error {
+	return mock.CreateMandateImportFunc(mandateImport)
+}
+
+func (mock *MockClient) CreateMandateImportWithContext(ctx context.Context, mandateImport *MandateImport) error {
+	if mock.CreateMandateImportWithContextFunc == nil {
+		return mock.CreateMandateImportFunc(mandateImport)
+	}
+	return mock.CreateMandateImportWithContextFunc(ctx, mandateImport)
+}
+
+func (mock *MockClient) GetMandateImport(id string) (*MandateImport, error) {
+	return mock.GetMandateImportFunc(id)
+}
+
+func (mock *MockClient) GetMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	if mock.GetMandateImportWithContextFunc == nil {
+		return mock.GetMandateImportFunc(id)
+	}
+	return mock.GetMandateImportWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) SubmitMandateImport(id string) (*MandateImport, error) {
+	return mock.SubmitMandateImportFunc(id)
+}
+
+func (mock *MockClient) SubmitMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	if mock.SubmitMandateImportWithContextFunc == nil {
+		return mock.SubmitMandateImportFunc(id)
+	}
+	return mock.SubmitMandateImportWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) CancelMandateImport(id string) (*MandateImport, error) {
+	return mock.CancelMandateImportFunc(id)
+}
+
+func (mock *MockClient) CancelMandateImportWithContext(ctx context.Context, id string) (*MandateImport, error) {
+	if mock.CancelMandateImportWithContextFunc == nil {
+		return mock.CancelMandateImportFunc(id)
+	}
+	return mock.CancelMandateImportWithContextFunc(ctx, id)
+}
+
+func (mock *MockClient) AddMandateImportEntry(entry *MandateImportEntry) error {
+	return mock.AddMandateImportEntryFunc(entry)
+}
+
+func (mock *MockClient) AddMandateImportEntryWithContext(ctx context.Context, entry *MandateImportEntry) error {
+	if mock.AddMandateImportEntryWithContextFunc == nil {
+		return mock.AddMandateImportEntryFunc(entry)
+	}
+	return mock.AddMandateImportEntryWithContextFunc(ctx, entry)
+}
+
+func (mock *MockClient) ListMandateImportEntries(options *MandateImportEntryListOptions) ([]*MandateImportEntry, error) {
+	return mock.ListMandateImportEntriesFunc(options)
+}
+
+func (mock *MockClient) ListMandateImportEntriesWithContext(ctx context.Context, options *MandateImportEntryListOptions) ([]*MandateImportEntry, error) {
+	if mock.ListMandateImportEntriesWithContextFunc == nil {
+		return mock.ListMandateImportEntriesFunc(options)
+	}
+	return mock.ListMandateImportEntriesWithContextFunc(ctx, options)
+}
+
+func (mock *MockClient) LookupBankDetails(bankDetails *BankDetails) (*BankDetailsLookup, error) {
+	return mock.LookupBankDetailsFunc(bankDetails)
+}
+
+func (mock *MockClient) LookupBankDetailsWithContext(ctx context.Context, bankDetails *BankDetails) (*BankDetailsLookup, error) {
+	if mock.LookupBankDetailsWithContextFunc == nil {
+		return mock.LookupBankDetailsFunc(bankDetails)
+	}
+	return mock.LookupBankDetailsWithContextFunc(ctx, bankDetails)
+}
+
+func (mock *MockClient) CreateMandatePDF(request *MandatePDFRequest, language string) (*MandatePDF, error) {
+	return mock.CreateMandatePDFFunc(request, language)
+}
+
+func (mock *MockClient) CreateMandatePDFWithContext(ctx context.Context, request *MandatePDFRequest, language string) (*MandatePDF, error) {
+	if mock.CreateMandatePDFWithContextFunc == nil {
+		return mock.CreateMandatePDFFunc(request, language)
+	}
+	return mock.CreateMandatePDFWithContextFunc(ctx, request, language)
+}
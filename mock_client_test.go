@@ -3,6 +3,7 @@ package gocardless
 import (
 	"testing"
 
+	"context"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -75,3 +76,129 @@ func TestMockClientListCustomer(t *testing.T) {
 		})
 	})
 }
+
+func TestMockClientImplementsAPI(t *testing.T) {
+	Convey(`Given I have a MockClient`, t, func() {
+		var client interface{} = &MockClient{}
+
+		Convey(`Then it will implement the API interface`, func() {
+			_, ok := client.(API)
+			So(ok, ShouldBeTrue)
+		})
+	})
+}
+
+func TestMockClientCreateCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a MockClient`, t, func() {
+		client := &MockClient{}
+
+		Convey(`And I have a function to mock CreateCustomerBankAccount`, func() {
+			isCalled := false
+
+			client.CreateCustomerBankAccountFunc = func(account *CustomerBankAccount) error {
+				isCalled = true
+				return nil
+			}
+
+			Convey(`When I call CreateCustomerBankAccount`, func() {
+				client.CreateCustomerBankAccount(&CustomerBankAccount{})
+
+				Convey(`Then the mock function is called`, func() {
+					So(isCalled, ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func TestMockClientDisableCustomerBankAccount(t *testing.T) {
+	Convey(`Given I have a MockClient`, t, func() {
+		client := &MockClient{}
+
+		Convey(`And I have a function to mock DisableCustomerBankAccount`, func() {
+			var calledID string
+
+			client.DisableCustomerBankAccountFunc = func(id string) (*CustomerBankAccount, error) {
+				calledID = id
+				return nil, nil
+			}
+
+			Convey(`When I call DisableCustomerBankAccount`, func() {
+				client.DisableCustomerBankAccount(`BA123`)
+
+				Convey(`Then the mock function is called with the ID`, func() {
+					So(calledID, ShouldEqual, `BA123`)
+				})
+			})
+		})
+	})
+}
+
+func TestMockClientCancelMandate(t *testing.T) {
+	Convey(`Given I have a MockClient`, t, func() {
+		client := &MockClient{}
+
+		Convey(`And I have a function to mock CancelMandate`, func() {
+			var calledID string
+
+			client.CancelMandateFunc = func(id string) (*Mandate, error) {
+				calledID = id
+				return &Mandate{ID: id, Status: MandateCancelled}, nil
+			}
+
+			Convey(`When I call CancelMandate`, func() {
+				mandate, _ := client.CancelMandate(`MD123`)
+
+				Convey(`Then the mock function is called with the ID`, func() {
+					So(calledID, ShouldEqual, `MD123`)
+				})
+
+				Convey(`Then the mocked mandate is returned`, func() {
+					So(mandate.Status, ShouldEqual, MandateCancelled)
+				})
+			})
+		})
+	})
+}
+
+func TestMockClientGetCustomerWithContext(t *testing.T) {
+	Convey(`Given I have a MockClient`, t, func() {
+		client := &MockClient{}
+
+		Convey(`And I have a function to mock GetCustomer`, func() {
+			var calledID string
+
+			client.GetCustomerFunc = func(id string) (*Customer, error) {
+				calledID = id
+				return nil, nil
+			}
+
+			Convey(`When I call GetCustomerWithContext`, func() {
+				client.GetCustomerWithContext(context.Background(), `CU123`)
+
+				Convey(`Then the function without a context is called`, func() {
+					So(calledID, ShouldEqual, `CU123`)
+				})
+			})
+
+			Convey(`And I have a function to mock GetCustomerWithContext`, func() {
+				var calledCtx context.Context
+
+				client.GetCustomerWithContextFunc = func(ctx context.Context, id string) (*Customer, error) {
+					calledCtx = ctx
+					return nil, nil
+				}
+
+				Convey(`When I call GetCustomerWithContext`, func() {
+					ctx := context.WithValue(context.Background(), `key`, `value`)
+					client.GetCustomerWithContext(ctx, `CU123`)
+
+					Convey(`Then the function with a context is called`, func() {
+						So(calledCtx, ShouldEqual, ctx)
+						So(calledID, ShouldEqual, ``)
+					})
+				})
+			})
+		})
+	})
+}
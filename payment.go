@@ -0,0 +1,97 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// PaymentStatus describes the state of a Payment
+type PaymentStatus string
+
+const (
+	// PaymentPendingCustomerApproval means the payment is waiting for the customer to approve it
+	PaymentPendingCustomerApproval PaymentStatus = `pending_customer_approval`
+	// PaymentPendingSubmission means the payment has been created, but not yet submitted to the banks
+	PaymentPendingSubmission PaymentStatus = `pending_submission`
+	// PaymentSubmitted means the payment has been submitted to the banks
+	PaymentSubmitted PaymentStatus = `submitted`
+	// PaymentConfirmed means the payment has been confirmed as collected
+	PaymentConfirmed PaymentStatus = `confirmed`
+	// PaymentPaidOut means the payment has been included in a payout
+	PaymentPaidOut PaymentStatus = `paid_out`
+	// PaymentCancelled means the payment has been cancelled
+	PaymentCancelled PaymentStatus = `cancelled`
+	// PaymentCustomerApprovalDenied means the customer has denied approval for the payment
+	PaymentCustomerApprovalDenied PaymentStatus = `customer_approval_denied`
+	// PaymentFailed means the payment failed to be processed
+	PaymentFailed PaymentStatus = `failed`
+	// PaymentChargedBack means the payment has been charged back
+	PaymentChargedBack PaymentStatus = `charged_back`
+)
+
+// Payment represents a single payment collected against a Mandate
+type Payment struct {
+	// ID is a unique identifier, beginning with “PM”.
+	ID string `json:"id,omitempty"`
+	// Amount is the amount in minor unit (e.g. pence in GBP, cents in EUR).
+	Amount int `json:"amount,omitempty"`
+	// AmountRefunded is the amount which has been refunded, in minor unit.
+	AmountRefunded int `json:"amount_refunded,omitempty"`
+	// AppFee is the amount to be deducted from the payment as the OAuth app’s fee, in minor unit.
+	AppFee int `json:"app_fee,omitempty"`
+	// ChargeDate is a future date, in the format YYYY-MM-DD, on which the payment should be collected. If not
+	// specified, the payment will be collected as soon as possible.
+	ChargeDate string `json:"charge_date,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the payment was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code, such as “GBP” or “EUR”.
+	Currency string `json:"currency,omitempty"`
+	// Description is a human-readable description of the payment. This will be included in the notification email
+	// GoCardless sends to your customer if your organisation does not send its own notifications.
+	Description string `json:"description,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Reference is an optional reference that will appear on your customer’s bank statement.
+	Reference string `json:"reference,omitempty"`
+	// RetryIfPossible will cause a failed payment to be retried automatically when set to true.
+	RetryIfPossible bool `json:"retry_if_possible,omitempty"`
+	// Status is the current state of the payment.
+	Status PaymentStatus `json:"status,omitempty"`
+	// Links holds the IDs of the resources the payment is associated with
+	Links *PaymentLinks `json:"links,omitempty"`
+}
+
+// PaymentLinks holds the IDs of the resources linked to a Payment
+type PaymentLinks struct {
+	// Creditor is the ID of the creditor to which the payment is paid out.
+	Creditor string `json:"creditor,omitempty"`
+	// InstalmentSchedule is the ID of the instalment schedule from which this payment was created.
+	InstalmentSchedule string `json:"instalment_schedule,omitempty"`
+	// Mandate is the ID of the mandate against which the payment is collected.
+	Mandate string `json:"mandate,omitempty"`
+	// Payout is the ID of the payout which includes this payment, once it has been paid out.
+	Payout string `json:"payout,omitempty"`
+	// Subscription is the ID of the subscription from which this payment was created.
+	Subscription string `json:"subscription,omitempty"`
+}
+
+// PaymentListOptions holds the parameters used to filter the results of ListPayments
+type PaymentListOptions struct {
+	ListOptions
+	// ChargeDateFrom restricts the results to payments charged on or after this date, in the format YYYY-MM-DD
+	ChargeDateFrom string `url:"charge_date[gte]"`
+	// ChargeDateTo restricts the results to payments charged on or before this date, in the format YYYY-MM-DD
+	ChargeDateTo string `url:"charge_date[lte]"`
+	// Creditor restricts the results to payments for this creditor ID
+	Creditor string `url:"creditor"`
+	// Currency restricts the results to payments in this ISO 4217 currency
+	Currency string `url:"currency"`
+	// Customer restricts the results to payments from this customer ID
+	Customer string `url:"customer"`
+	// Mandate restricts the results to payments collected against this mandate ID
+	Mandate string `url:"mandate"`
+	// Status restricts the results to payments in this state
+	Status PaymentStatus `url:"status"`
+	// Subscription restricts the results to payments created by this subscription ID
+	Subscription string `url:"subscription"`
+}
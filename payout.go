@@ -0,0 +1,140 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// PayoutStatus describes the state of a Payout
+type PayoutStatus string
+
+const (
+	// PayoutPending means the payout has been created, but not yet sent to the banks
+	PayoutPending PayoutStatus = `pending`
+	// PayoutPaid means the payout has been sent to the banks
+	PayoutPaid PayoutStatus = `paid`
+	// PayoutBounced means the payout bounced when sent, and will be retried
+	PayoutBounced PayoutStatus = `bounced`
+)
+
+// PayoutItemType describes the kind of movement of money represented by a PayoutItem
+type PayoutItemType string
+
+const (
+	// PayoutItemPaymentPaidOut is the credit for a successfully collected payment
+	PayoutItemPaymentPaidOut PayoutItemType = `payment_paid_out`
+	// PayoutItemPaymentFailed is the debit for a payment that failed after it had been paid out
+	PayoutItemPaymentFailed PayoutItemType = `payment_failed`
+	// PayoutItemPaymentChargedBack is the debit for a payment that was charged back after it had been paid out
+	PayoutItemPaymentChargedBack PayoutItemType = `payment_charged_back`
+	// PayoutItemPaymentRefunded is the debit for a refund of a payment
+	PayoutItemPaymentRefunded PayoutItemType = `payment_refunded`
+	// PayoutItemRefund is the debit for a refund that was not linked to a payment
+	PayoutItemRefund PayoutItemType = `refund`
+	// PayoutItemGoCardlessFee is the debit for the GoCardless fee on a payment
+	PayoutItemGoCardlessFee PayoutItemType = `gocardless_fee`
+	// PayoutItemAppFee is the debit for an app fee on a payment
+	PayoutItemAppFee PayoutItemType = `app_fee`
+	// PayoutItemRevenueShare is the credit for a share of the GoCardless fee of a payment
+	PayoutItemRevenueShare PayoutItemType = `revenue_share`
+	// PayoutItemSurchargeFee is the debit for the surcharge fee on a payment
+	PayoutItemSurchargeFee PayoutItemType = `surcharge_fee`
+)
+
+// Payout is a transfer of collected funds, less fees, from GoCardless to a creditor bank account
+type Payout struct {
+	// ID is a unique identifier, beginning with “PO”.
+	ID string `json:"id,omitempty"`
+	// Amount is the amount in minor unit (e.g. pence in GBP, cents in EUR).
+	Amount int `json:"amount,omitempty"`
+	// ArrivalDate is the date, in the format YYYY-MM-DD, the payout is expected to arrive in the creditor’s bank
+	// account.
+	ArrivalDate string `json:"arrival_date,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the payout was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code, such as “GBP” or “EUR”.
+	Currency string `json:"currency,omitempty"`
+	// DeductedFees is the total of the fees deducted from the payout, in minor unit.
+	DeductedFees int `json:"deducted_fees,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// PayoutType is whether the payout was created for a “merchant” or “partner”.
+	PayoutType string `json:"payout_type,omitempty"`
+	// Reference is the reference which appears on the creditor’s bank statement.
+	Reference string `json:"reference,omitempty"`
+	// Status is the current state of the payout.
+	Status PayoutStatus `json:"status,omitempty"`
+	// Links holds the IDs of the resources the payout is associated with
+	Links *PayoutLinks `json:"links,omitempty"`
+}
+
+// PayoutLinks holds the IDs of the resources linked to a Payout
+type PayoutLinks struct {
+	// Creditor is the ID of the creditor that receives the payout.
+	Creditor string `json:"creditor,omitempty"`
+	// CreditorBankAccount is the ID of the bank account the payout is sent to.
+	CreditorBankAccount string `json:"creditor_bank_account,omitempty"`
+}
+
+// PayoutListOptions holds the parameters used to filter the results of ListPayouts
+type PayoutListOptions struct {
+	ListOptions
+	// Creditor restricts the results to payouts for this creditor ID
+	Creditor string `url:"creditor"`
+	// CreditorBankAccount restricts the results to payouts sent to this creditor bank account ID
+	CreditorBankAccount string `url:"creditor_bank_account"`
+	// Currency restricts the results to payouts in this ISO 4217 currency
+	Currency string `url:"currency"`
+	// PayoutType restricts the results to “merchant” or “partner” payouts
+	PayoutType string `url:"payout_type"`
+	// Reference restricts the results to the payout with this reference
+	Reference string `url:"reference"`
+	// Status restricts the results to payouts in this state
+	Status PayoutStatus `url:"status"`
+}
+
+// PayoutItem is a single movement of money which makes up part of a Payout, such as a collected payment or a fee
+type PayoutItem struct {
+	// Amount is the positive (credit) or negative (debit) value of the item, in major unit as a decimal string
+	// (e.g. “1.00” for £1).
+	Amount string `json:"amount"`
+	// Type is the kind of movement of money the item represents.
+	Type PayoutItemType `json:"type"`
+	// Taxes is the tax charged on a fee item. Only present for fees.
+	Taxes []*PayoutItemTax `json:"taxes,omitempty"`
+	// Links holds the IDs of the resources the item is associated with
+	Links *PayoutItemLinks `json:"links,omitempty"`
+}
+
+// PayoutItemLinks holds the IDs of the resources linked to a PayoutItem
+type PayoutItemLinks struct {
+	// Mandate is the ID of the mandate the item relates to.
+	Mandate string `json:"mandate,omitempty"`
+	// Payment is the ID of the payment the item relates to.
+	Payment string `json:"payment,omitempty"`
+	// Refund is the ID of the refund the item relates to.
+	Refund string `json:"refund,omitempty"`
+}
+
+// PayoutItemTax is the tax applied to a fee included in a PayoutItem
+type PayoutItemTax struct {
+	// Amount is the amount of tax applied to the fee, in major unit as a decimal string.
+	Amount string `json:"amount"`
+	// Currency is the ISO 4217 currency code of the tax.
+	Currency string `json:"currency"`
+	// DestinationAmount is the amount of tax to be paid out to the tax authority, in the currency of the authority.
+	DestinationAmount string `json:"destination_amount,omitempty"`
+	// DestinationCurrency is the ISO 4217 currency code of the tax authority.
+	DestinationCurrency string `json:"destination_currency,omitempty"`
+	// ExchangeRate is the rate used to convert Amount into DestinationAmount.
+	ExchangeRate string `json:"exchange_rate,omitempty"`
+	// TaxRateID is the unique identifier of the tax rate applied.
+	TaxRateID string `json:"tax_rate_id,omitempty"`
+}
+
+// PayoutItemListOptions holds the parameters used to filter the results of ListPayoutItems
+type PayoutItemListOptions struct {
+	ListOptions
+	// Payout is the ID of the payout whose items should be returned. It is required by the API
+	Payout string `url:"payout"`
+}
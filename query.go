@@ -0,0 +1,98 @@
+package gocardless
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// ListOptions contains the cursor pagination parameters which are accepted by every list endpoint. It is embedded in
+// the options type of each resource
+type ListOptions struct {
+	// After is the cursor used to return records after the record with this ID
+	After string `url:"after"`
+	// Before is the cursor used to return records before the record with this ID
+	Before string `url:"before"`
+	// Limit is the number of records to return, up to a maximum of 500
+	Limit int `url:"limit"`
+}
+
+// encodeQuery converts the fields of an options struct into a query string, using the name given in the url tag of
+// each field. Fields holding their zero value are omitted, as are fields without a url tag. Embedded structs are
+// flattened into the same query string
+func encodeQuery(options interface{}) string {
+	values := url.Values{}
+	addQueryValues(values, reflect.ValueOf(options))
+	return values.Encode()
+}
+
+func addQueryValues(values url.Values, v reflect.Value) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		if field.Anonymous {
+			addQueryValues(values, value)
+			continue
+		}
+
+		name := field.Tag.Get(`url`)
+		if name == `` || name == `-` {
+			continue
+		}
+
+		if encoded, ok := queryValue(value); ok {
+			values.Set(name, encoded)
+		}
+	}
+}
+
+// queryValue returns the string representation of value and whether it should be included in the query string. A
+// non-nil pointer is always included, allowing false and zero to be sent as filters
+func queryValue(value reflect.Value) (string, bool) {
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ``, false
+		}
+		encoded, _ := queryValue(value.Elem())
+		return encoded, true
+	}
+
+	if t, ok := value.Interface().(time.Time); ok {
+		if t.IsZero() {
+			return ``, false
+		}
+		return t.Format(time.RFC3339), true
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), value.Len() > 0
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), value.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), value.Int() != 0
+	}
+	return fmt.Sprint(value.Interface()), !value.IsZero()
+}
+
+// withQuery appends the encoded options to path
+func withQuery(path string, options interface{}) string {
+	if query := encodeQuery(options); query != `` {
+		return fmt.Sprintf(`%s?%s`, path, query)
+	}
+	return path
+}
@@ -0,0 +1,38 @@
+package gocardless
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	Convey(`Given I have a set of list options`, t, func() {
+		enabled := false
+		options := &CustomerBankAccountListOptions{
+			ListOptions: ListOptions{Limit: 10, After: `BA100`},
+			Customer:    `CU123`,
+			Enabled:     &enabled,
+		}
+
+		Convey(`When I call encodeQuery`, func() {
+			query := encodeQuery(options)
+
+			Convey(`Then the embedded pagination fields and filters will be encoded`, func() {
+				So(query, ShouldEqual, `after=BA100&customer=CU123&enabled=false&limit=10`)
+			})
+		})
+	})
+
+	Convey(`Given I have empty list options`, t, func() {
+		options := &CustomerBankAccountListOptions{}
+
+		Convey(`When I call encodeQuery`, func() {
+			query := encodeQuery(options)
+
+			Convey(`Then the query will be empty`, func() {
+				So(query, ShouldEqual, ``)
+			})
+		})
+	})
+}
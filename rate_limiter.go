@@ -0,0 +1,98 @@
+package gocardless
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateBudget is the state of the GoCardless rate limit, as last reported by the API
+type RateBudget struct {
+	// Limit is the number of requests permitted in each rate limit window.
+	Limit int
+	// Remaining is the number of requests which may still be made in the current window. Requests made since the
+	// last response are deducted from it.
+	Remaining int
+	// Reset is when the current window ends and the full limit becomes available again.
+	Reset time.Time
+}
+
+// RateLimiter tracks the rate limit reported in the RateLimit headers of each response, and delays requests once the
+// remaining budget falls to its Threshold until the limit is reset. A single RateLimiter may be shared by any number
+// of goroutines, and by several Clients using the same access token
+type RateLimiter struct {
+	// Threshold is the number of remaining requests below which requests are delayed. Keeping some budget in reserve
+	// allows for other processes using the same access token.
+	Threshold int
+	// OnUpdate, when set, is called with the budget each time a response updates it, for example to report it to a
+	// monitoring system. It must not call the methods of the RateLimiter.
+	OnUpdate func(RateBudget)
+
+	mutex  sync.Mutex
+	budget RateBudget
+}
+
+// NewRateLimiter returns a RateLimiter which delays requests once the remaining budget falls to threshold
+func NewRateLimiter(threshold int) *RateLimiter {
+	return &RateLimiter{Threshold: threshold}
+}
+
+// Budget returns the current rate limit budget. The Limit is 0 until the first response has been received
+func (l *RateLimiter) Budget() RateBudget {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.budget
+}
+
+// wait blocks until a request may be made, deducting it from the budget. It returns early with the error of ctx if it
+// is done first
+func (l *RateLimiter) wait(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if !l.budget.Reset.IsZero() && !time.Now().Before(l.budget.Reset) {
+			l.budget.Remaining = l.budget.Limit
+			l.budget.Reset = time.Time{}
+		}
+
+		// Requests proceed when the limit is not yet known, and when the window has been reset but no response has
+		// reported when the next one ends, as there is no time to wait until
+		if l.budget.Limit == 0 || l.budget.Remaining > l.Threshold || l.budget.Reset.IsZero() {
+			if l.budget.Remaining > 0 {
+				l.budget.Remaining--
+			}
+			l.mutex.Unlock()
+			return nil
+		}
+		reset := l.budget.Reset
+		l.mutex.Unlock()
+
+		if err := sleep(ctx, time.Until(reset)); err != nil {
+			return err
+		}
+	}
+}
+
+// update records the rate limit reported by resp. Responses without rate limit headers are ignored
+func (l *RateLimiter) update(resp *Response) {
+	limit := resp.RateLimit()
+	reset := resp.RateReset()
+	if limit == 0 || reset.IsZero() {
+		return
+	}
+
+	l.mutex.Lock()
+	remaining := resp.RateLimitRemaining()
+	// Requests sent after this response was produced have already been deducted from the budget of the same window
+	if reset.Equal(l.budget.Reset) && l.budget.Remaining < remaining {
+		remaining = l.budget.Remaining
+	}
+	l.budget = RateBudget{Limit: limit, Remaining: remaining, Reset: reset}
+	budget := l.budget
+	onUpdate := l.OnUpdate
+	l.mutex.Unlock()
+
+	if onUpdate != nil {
+		onUpdate(budget)
+	}
+}
@@ -0,0 +1,166 @@
+package gocardless
+
+import (
+	"testing"
+
+	"context"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+func rateLimitResponse(limit, remaining int, reset time.Time) *Response {
+	header := http.Header{}
+	header.Set(rateLimitHeader, strconv.Itoa(limit))
+	header.Set(rateLimitRemainingHeader, strconv.Itoa(remaining))
+	header.Set(rateLimitResetHeader, reset.UTC().Format(time.RFC1123))
+	return &Response{&http.Response{Header: header}}
+}
+
+func TestRateLimiter(t *testing.T) {
+	Convey(`Given I have a rate limiter`, t, func() {
+		limiter := NewRateLimiter(2)
+
+		var budgets []RateBudget
+		limiter.OnUpdate = func(budget RateBudget) {
+			budgets = append(budgets, budget)
+		}
+
+		var delays []time.Duration
+		originalSleep := sleep
+		defer func() { sleep = originalSleep }()
+		sleep = func(ctx context.Context, d time.Duration) error {
+			delays = append(delays, d)
+			// Move the reset into the past, as though the limiter had waited for it
+			limiter.budget.Reset = time.Now().Add(-time.Second)
+			return nil
+		}
+
+		Convey(`When no responses have been received`, func() {
+			Convey(`Then requests will not be delayed`, func() {
+				for i := 0; i < 5; i++ {
+					So(limiter.wait(context.Background()), ShouldBeNil)
+				}
+				So(delays, ShouldBeEmpty)
+			})
+		})
+
+		Convey(`When a response reports the rate limit`, func() {
+			reset := time.Now().Add(time.Minute).UTC().Truncate(time.Second)
+			limiter.update(rateLimitResponse(1000, 4, reset))
+
+			Convey(`Then the budget will be updated`, func() {
+				budget := limiter.Budget()
+				So(budget.Limit, ShouldEqual, 1000)
+				So(budget.Remaining, ShouldEqual, 4)
+				So(budget.Reset.Equal(reset), ShouldBeTrue)
+			})
+
+			Convey(`Then the budget will be reported to the hook`, func() {
+				So(budgets, ShouldHaveLength, 1)
+				So(budgets[0].Remaining, ShouldEqual, 4)
+			})
+
+			Convey(`Then requests will proceed until the threshold is reached`, func() {
+				So(limiter.wait(context.Background()), ShouldBeNil)
+				So(limiter.wait(context.Background()), ShouldBeNil)
+				So(delays, ShouldBeEmpty)
+				So(limiter.Budget().Remaining, ShouldEqual, 2)
+
+				Convey(`And the next request will wait until the limit is reset`, func() {
+					So(limiter.wait(context.Background()), ShouldBeNil)
+					So(delays, ShouldHaveLength, 1)
+					So(delays[0], ShouldBeGreaterThan, 58*time.Second)
+					So(limiter.Budget().Remaining, ShouldEqual, 999)
+				})
+			})
+
+			Convey(`Then a later response from the same window will not restore requests already deducted`, func() {
+				limiter.wait(context.Background())
+				limiter.update(rateLimitResponse(1000, 4, reset))
+				So(limiter.Budget().Remaining, ShouldEqual, 3)
+			})
+		})
+
+		Convey(`When the budget is exhausted and the context is cancelled while waiting`, func() {
+			limiter.update(rateLimitResponse(1000, 0, time.Now().Add(time.Minute)))
+			sleep = func(ctx context.Context, d time.Duration) error {
+				return context.Canceled
+			}
+
+			Convey(`Then the error of the context will be returned`, func() {
+				So(limiter.wait(context.Background()), ShouldEqual, context.Canceled)
+			})
+		})
+
+		Convey(`When many goroutines make requests at once`, func() {
+			limiter.update(rateLimitResponse(1000, 12, time.Now().Add(time.Minute)))
+			sleep = func(ctx context.Context, d time.Duration) error {
+				return context.Canceled
+			}
+
+			var wg sync.WaitGroup
+			var mutex sync.Mutex
+			proceeded := 0
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if limiter.wait(context.Background()) == nil {
+						mutex.Lock()
+						proceeded++
+						mutex.Unlock()
+					}
+				}()
+			}
+			wg.Wait()
+
+			Convey(`Then only the budget above the threshold will be used`, func() {
+				So(proceeded, ShouldEqual, 10)
+				So(limiter.Budget().Remaining, ShouldEqual, 2)
+			})
+		})
+	})
+}
+
+func TestClientRateLimiter(t *testing.T) {
+	Convey(`Given I have a client with a rate limiter`, t, func() {
+		limiter := NewRateLimiter(0)
+		client := &Client{RateLimiter: limiter}
+
+		Convey(`And I have a server which reports the rate limit`, func() {
+			status := http.StatusOK
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set(rateLimitHeader, `1000`)
+				w.Header().Set(rateLimitRemainingHeader, `998`)
+				w.Header().Set(rateLimitResetHeader, time.Now().Add(time.Minute).UTC().Format(time.RFC1123))
+				w.WriteHeader(status)
+				w.Write([]byte(`{"mandates": {"id": "MD123"}}`))
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I make a request`, func() {
+				_, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the budget will be taken from the response`, func() {
+					So(err, ShouldBeNil)
+					So(limiter.Budget().Limit, ShouldEqual, 1000)
+					So(limiter.Budget().Remaining, ShouldEqual, 998)
+				})
+			})
+
+			Convey(`When a request is rate limited`, func() {
+				status = http.StatusTooManyRequests
+				client.GetMandate(`MD123`)
+
+				Convey(`Then the budget will still be updated`, func() {
+					So(limiter.Budget().Remaining, ShouldEqual, 998)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// RedirectFlow is used to set up a mandate using the payment pages hosted by GoCardless. The customer is sent to the
+// RedirectURL, and once they are returned to the SuccessRedirectURL the flow is completed to create the customer,
+// customer bank account and mandate
+type RedirectFlow struct {
+	// ID is a unique identifier, beginning with “RE”.
+	ID string `json:"id,omitempty"`
+	// ConfirmationURL is the URL of a confirmation page, which may be shown to the customer once the flow has been
+	// completed, as returned by the API.
+	ConfirmationURL string `json:"confirmation_url,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the redirect flow was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Description is shown to the customer on the payment pages, describing what they are signing up for.
+	Description string `json:"description,omitempty"`
+	// PrefilledCustomer holds customer details used to prefill the payment pages. Only used on creation.
+	PrefilledCustomer *Customer `json:"prefilled_customer,omitempty"`
+	// RedirectURL is the URL of the hosted payment pages to send the customer to, as returned by the API.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// Scheme is the Direct Debit scheme of the mandate. If left blank the scheme is chosen from the customer’s bank
+	// account.
+	Scheme string `json:"scheme,omitempty"`
+	// SessionToken is the customer’s session ID in your application. It must be supplied again when completing the
+	// flow, ensuring that the same customer completes it.
+	SessionToken string `json:"session_token,omitempty"`
+	// SuccessRedirectURL is the URL the customer is returned to once the payment pages have been completed.
+	SuccessRedirectURL string `json:"success_redirect_url,omitempty"`
+	// Links holds the IDs of the resources the redirect flow is associated with. The customer, bank account and
+	// mandate are only set once the flow has been completed
+	Links *RedirectFlowLinks `json:"links,omitempty"`
+}
+
+// RedirectFlowLinks holds the IDs of the resources linked to a RedirectFlow
+type RedirectFlowLinks struct {
+	// Creditor is the ID of the creditor the mandate is created for.
+	Creditor string `json:"creditor,omitempty"`
+	// Customer is the ID of the customer created by the flow.
+	Customer string `json:"customer,omitempty"`
+	// CustomerBankAccount is the ID of the customer bank account created by the flow.
+	CustomerBankAccount string `json:"customer_bank_account,omitempty"`
+	// Mandate is the ID of the mandate created by the flow.
+	Mandate string `json:"mandate,omitempty"`
+}
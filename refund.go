@@ -0,0 +1,67 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// RefundStatus describes the state of a Refund
+type RefundStatus string
+
+const (
+	// RefundCreated means the refund has been created
+	RefundCreated RefundStatus = `created`
+	// RefundPendingSubmission means the refund has been created, but not yet submitted to the banks
+	RefundPendingSubmission RefundStatus = `pending_submission`
+	// RefundSubmitted means the refund has been submitted to the banks
+	RefundSubmitted RefundStatus = `submitted`
+	// RefundPaid means the refund has been included in a payout
+	RefundPaid RefundStatus = `paid`
+	// RefundCancelled means the refund has been cancelled
+	RefundCancelled RefundStatus = `cancelled`
+	// RefundBounced means the refund has failed to be paid
+	RefundBounced RefundStatus = `bounced`
+	// RefundFundsReturned means the refund has had its funds returned
+	RefundFundsReturned RefundStatus = `funds_returned`
+)
+
+// Refund represents a refund of all or part of a Payment back to the customer
+type Refund struct {
+	// ID is a unique identifier, beginning with “RF”.
+	ID string `json:"id,omitempty"`
+	// Amount is the amount in minor unit (e.g. pence in GBP, cents in EUR).
+	Amount int `json:"amount,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the refund was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code, as returned by the API. This is always the currency of the payment.
+	Currency string `json:"currency,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Reference is an optional reference that will appear on your customer’s bank statement.
+	Reference string `json:"reference,omitempty"`
+	// Status is the current state of the refund.
+	Status RefundStatus `json:"status,omitempty"`
+	// TotalAmountConfirmation is the total amount in minor unit that will have been refunded from the payment once
+	// this refund is created, including any earlier refunds. It is required on creation and protects against
+	// accidentally refunding the same payment twice. Only used on creation.
+	TotalAmountConfirmation int `json:"total_amount_confirmation,omitempty"`
+	// Links holds the IDs of the resources the refund is associated with
+	Links *RefundLinks `json:"links,omitempty"`
+}
+
+// RefundLinks holds the IDs of the resources linked to a Refund
+type RefundLinks struct {
+	// Mandate is the ID of the mandate against which the refund is being made.
+	Mandate string `json:"mandate,omitempty"`
+	// Payment is the ID of the payment against which the refund is being made.
+	Payment string `json:"payment,omitempty"`
+}
+
+// RefundListOptions holds the parameters used to filter the results of ListRefunds
+type RefundListOptions struct {
+	ListOptions
+	// Mandate restricts the results to refunds against this mandate ID
+	Mandate string `url:"mandate"`
+	// Payment restricts the results to refunds against this payment ID
+	Payment string `url:"payment"`
+}
@@ -0,0 +1,120 @@
+package gocardless
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy describes how a Client retries requests which fail due to a network error, a 5xx response or the rate
+// limit being exceeded. Only requests which are safe to repeat are retried: GET and HEAD requests, and requests sent
+// with an Idempotency-Key such as those of the create methods
+type RetryPolicy struct {
+	// MaxAttempts is the total number of times a request is attempted, including the first. Values below 1 are
+	// treated as 1, so that the request is not retried.
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry. The delay doubles with each further retry.
+	BaseBackoff time.Duration
+	// MaxBackoff is the longest delay between attempts, other than when waiting for the rate limit to reset. When zero
+	// the delay is not capped.
+	MaxBackoff time.Duration
+	// Jitter is the fraction of each delay, between 0 and 1, which is randomised so that clients retrying at the
+	// same time spread out their requests. Values outside of that range are clamped to it.
+	Jitter float64
+}
+
+// uncappedBackoff is the delay used by a RetryPolicy without a MaxBackoff once doubling the delay would overflow
+const uncappedBackoff = time.Duration(math.MaxInt64 / 2)
+
+// DefaultRetryPolicy returns a RetryPolicy which attempts each request up to 3 times, waiting up to half a second
+// before the first retry and up to a second before the second
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		Jitter:      0.5,
+	}
+}
+
+// attempts returns the number of times a request may be attempted, which is at least 1
+func (policy *RetryPolicy) attempts() int {
+	if policy.MaxAttempts < 1 {
+		return 1
+	}
+	return policy.MaxAttempts
+}
+
+// jitter returns the Jitter of the policy clamped between 0 and 1
+func (policy *RetryPolicy) jitter() float64 {
+	switch {
+	case policy.Jitter < 0:
+		return 0
+	case policy.Jitter > 1:
+		return 1
+	}
+	return policy.Jitter
+}
+
+// delay returns how long to wait before making the next attempt after attempt failed with err. When the rate limit
+// has been exceeded this is until the limit resets, otherwise it is an exponential backoff
+func (policy *RetryPolicy) delay(attempt int, err error) time.Duration {
+	var rateLimitErr *RateLimitedExceededError
+	if errors.As(err, &rateLimitErr) && !rateLimitErr.RateReset.IsZero() {
+		if wait := time.Until(rateLimitErr.RateReset); wait > 0 {
+			return wait
+		}
+	}
+
+	shift := uint(attempt - 1)
+	backoff := policy.BaseBackoff << shift
+	if shift >= 63 || backoff>>shift != policy.BaseBackoff || backoff > uncappedBackoff {
+		backoff = uncappedBackoff
+	}
+	if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+	if fraction := policy.jitter(); fraction > 0 {
+		jitter := time.Duration(fraction * float64(backoff))
+		backoff = backoff - jitter + time.Duration(rand.Int63n(int64(jitter)+1))
+	}
+	return backoff
+}
+
+// isRetryable reports whether a request using method and sent with header is safe to repeat
+func isRetryable(method string, header http.Header) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	}
+	return header.Get(`Idempotency-Key`) != ``
+}
+
+// shouldRetry reports whether a request which completed with the status code, or failed with err, should be retried.
+// Requests whose context has been cancelled or has expired are not retried
+func shouldRetry(ctx context.Context, statusCode int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return statusCode >= http.StatusInternalServerError
+}
+
+// sleep waits for d, returning early with the error of ctx if it is done first. It is a variable so that tests can
+// avoid waiting
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
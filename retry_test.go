@@ -0,0 +1,290 @@
+package gocardless
+
+import (
+	"testing"
+
+	"context"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+func TestClientRetries(t *testing.T) {
+	Convey(`Given I have a client with a retry policy`, t, func() {
+		client := &Client{
+			RetryPolicy: &RetryPolicy{
+				MaxAttempts: 3,
+				BaseBackoff: 100 * time.Millisecond,
+				MaxBackoff:  time.Second,
+			},
+		}
+
+		var delays []time.Duration
+		originalSleep := sleep
+		sleep = func(_ context.Context, d time.Duration) error {
+			delays = append(delays, d)
+			return nil
+		}
+		defer func() { sleep = originalSleep }()
+
+		Convey(`And I have a server which fails twice before succeeding`, func() {
+			var keys []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				keys = append(keys, req.Header.Get(`Idempotency-Key`))
+				if len(keys) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"mandates": {"id": "MD123"}}`))
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I get a mandate`, func() {
+				mandate, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the request will succeed on the third attempt`, func() {
+					So(err, ShouldBeNil)
+					So(mandate.ID, ShouldEqual, `MD123`)
+					So(keys, ShouldHaveLength, 3)
+				})
+
+				Convey(`Then the delay will double between attempts`, func() {
+					So(delays, ShouldResemble, []time.Duration{100 * time.Millisecond, 200 * time.Millisecond})
+				})
+			})
+
+			Convey(`When I create a mandate`, func() {
+				err := client.CreateMandate(&Mandate{})
+
+				Convey(`Then the request will be retried with the same Idempotency-Key`, func() {
+					So(err, ShouldBeNil)
+					So(keys, ShouldHaveLength, 3)
+					So(keys[1], ShouldEqual, keys[0])
+					So(keys[2], ShouldEqual, keys[0])
+				})
+			})
+
+			Convey(`When I cancel a mandate`, func() {
+				_, err := client.CancelMandate(`MD123`)
+
+				Convey(`Then the request will not be retried as it has no Idempotency-Key`, func() {
+					So(err, ShouldNotBeNil)
+					So(keys, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey(`And I have a server which always returns a server error`, func() {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I get a mandate`, func() {
+				_, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the error of the last attempt will be returned`, func() {
+					So(requests, ShouldEqual, 3)
+					So(err, ShouldHaveSameTypeAs, GoCardlessError{})
+					So(err.(GoCardlessError).Err.Code, ShouldEqual, http.StatusInternalServerError)
+				})
+			})
+		})
+
+		Convey(`And I have a server which fails the first attempt of a create and then reports a conflict`, func() {
+			var requests []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests = append(requests, req.Method+` `+req.URL.Path)
+				switch {
+				case req.Method == http.MethodGet:
+					w.Write([]byte(`{"customers": {"id": "CU123"}}`))
+				case len(requests) == 1:
+					w.WriteHeader(http.StatusBadGateway)
+				default:
+					w.WriteHeader(http.StatusConflict)
+					w.Write([]byte(idempotentConflictResponse))
+				}
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I create a customer without RecoverIdempotentConflicts set`, func() {
+				customer := &Customer{}
+				err := client.CreateCustomer(customer)
+
+				Convey(`Then the customer created by the first attempt will be recovered`, func() {
+					So(err, ShouldBeNil)
+					So(customer.ID, ShouldEqual, `CU123`)
+					So(requests, ShouldResemble, []string{`POST /customers`, `POST /customers`, `GET /customers/CU123`})
+				})
+			})
+		})
+
+		Convey(`And I have a server which reports a conflict on the first attempt of a create`, func() {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(idempotentConflictResponse))
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I create a customer without RecoverIdempotentConflicts set`, func() {
+				err := client.CreateCustomer(&Customer{})
+
+				Convey(`Then the conflict will be returned without recovering the customer`, func() {
+					So(err, ShouldHaveSameTypeAs, IdempotentCreationConflictError{})
+					So(requests, ShouldEqual, 1)
+				})
+			})
+		})
+
+		Convey(`And I have a server which is rate limiting requests`, func() {
+			requests := 0
+			reset := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				w.Header().Set(rateLimitResetHeader, reset.Format(time.RFC1123))
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I get a mandate`, func() {
+				_, err := client.GetMandate(`MD123`)
+
+				Convey(`Then each retry will wait until the rate limit resets`, func() {
+					So(requests, ShouldEqual, 3)
+					So(delays, ShouldHaveLength, 2)
+					So(delays[0], ShouldBeGreaterThan, 59*time.Minute)
+				})
+
+				Convey(`Then a RateLimitedExceededError will be returned`, func() {
+					var rateLimitErr *RateLimitedExceededError
+					So(errors.As(err, &rateLimitErr), ShouldBeTrue)
+					So(rateLimitErr.RateReset.Equal(reset), ShouldBeTrue)
+				})
+			})
+
+			Convey(`And the client has no retry policy`, func() {
+				client.RetryPolicy = nil
+
+				Convey(`When I get a mandate`, func() {
+					_, err := client.GetMandate(`MD123`)
+
+					Convey(`Then the request will only be attempted once`, func() {
+						So(requests, ShouldEqual, 1)
+						So(err, ShouldHaveSameTypeAs, &RateLimitedExceededError{})
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	Convey(`Given I have a retry policy with jitter`, t, func() {
+		policy := &RetryPolicy{
+			MaxAttempts: 10,
+			BaseBackoff: 100 * time.Millisecond,
+			MaxBackoff:  time.Second,
+			Jitter:      0.5,
+		}
+
+		Convey(`When I calculate the delay of each attempt`, func() {
+			Convey(`Then the delay will be within the jitter of the backoff`, func() {
+				for i := 0; i < 20; i++ {
+					delay := policy.delay(2, nil)
+					So(delay, ShouldBeGreaterThanOrEqualTo, 100*time.Millisecond)
+					So(delay, ShouldBeLessThanOrEqualTo, 200*time.Millisecond)
+				}
+			})
+
+			Convey(`Then the delay will not exceed the maximum backoff`, func() {
+				So(policy.delay(9, nil), ShouldBeLessThanOrEqualTo, time.Second)
+			})
+		})
+	})
+
+	Convey(`Given I have a retry policy which only sets a base backoff`, t, func() {
+		policy := &RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Second}
+
+		Convey(`Then the delay will double without a cap`, func() {
+			So(policy.delay(1, nil), ShouldEqual, time.Second)
+			So(policy.delay(2, nil), ShouldEqual, 2*time.Second)
+			So(policy.delay(6, nil), ShouldEqual, 32*time.Second)
+		})
+
+		Convey(`Then a rate limit without a reset time will wait for the backoff`, func() {
+			So(policy.delay(1, &RateLimitedExceededError{}), ShouldEqual, time.Second)
+		})
+
+		Convey(`Then a delay which would overflow will stay positive`, func() {
+			So(policy.delay(100, nil), ShouldBeGreaterThan, time.Second)
+		})
+	})
+
+	Convey(`Given I have a retry policy with a jitter greater than 1`, t, func() {
+		policy := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Jitter: 3}
+
+		Convey(`Then the delay will never be negative`, func() {
+			for i := 0; i < 20; i++ {
+				delay := policy.delay(1, nil)
+				So(delay, ShouldBeGreaterThanOrEqualTo, 0)
+				So(delay, ShouldBeLessThanOrEqualTo, 100*time.Millisecond)
+			}
+		})
+	})
+
+	Convey(`Given I have a retry policy with a negative jitter`, t, func() {
+		policy := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Jitter: -1}
+
+		Convey(`Then the delay will not be randomised`, func() {
+			So(policy.delay(1, nil), ShouldEqual, 100*time.Millisecond)
+		})
+	})
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	Convey(`Given I have a client whose retry policy has no attempts`, t, func() {
+		client := &Client{RetryPolicy: &RetryPolicy{MaxAttempts: 0}}
+
+		Convey(`And I have a server which always returns a server error`, func() {
+			requests := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				requests++
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer srv.Close()
+			client.RemoteURL = srv.URL
+
+			Convey(`When I get a mandate`, func() {
+				_, err := client.GetMandate(`MD123`)
+
+				Convey(`Then the request will be attempted once`, func() {
+					So(err, ShouldNotBeNil)
+					So(requests, ShouldEqual, 1)
+				})
+			})
+
+			Convey(`And the retry policy has a negative number of attempts`, func() {
+				client.RetryPolicy.MaxAttempts = -2
+
+				Convey(`When I get a mandate`, func() {
+					client.GetMandate(`MD123`)
+
+					Convey(`Then the request will be attempted once`, func() {
+						So(requests, ShouldEqual, 1)
+					})
+				})
+			})
+		})
+	})
+}
@@ -1,19 +1,21 @@
 package gocardless
 
+// Scheme holds the identifiers and contact details of a creditor for a particular Direct Debit scheme, as returned in
+// the SchemeIdentifiers of a Creditor
 type Scheme struct {
-	AddressLine1               string
-	AddressLine2               string
-	AddressLine3               string
-	CanSpecifyMandateReference bool
-	City                       string
-	CountryCode                string
-	Currency                   string
-	Email                      string
-	MinimumAdvanceNotice       int
-	Name                       string
-	PhoneNumber                string
-	PostalCode                 string
-	Reference                  string
-	Region                     string
-	Scheme                     string
+	AddressLine1               string `json:"address_line1"`
+	AddressLine2               string `json:"address_line2"`
+	AddressLine3               string `json:"address_line3"`
+	CanSpecifyMandateReference bool   `json:"can_specify_mandate_reference"`
+	City                       string `json:"city"`
+	CountryCode                string `json:"country_code"`
+	Currency                   string `json:"currency"`
+	Email                      string `json:"email"`
+	MinimumAdvanceNotice       int    `json:"minimum_advance_notice"`
+	Name                       string `json:"name"`
+	PhoneNumber                string `json:"phone_number"`
+	PostalCode                 string `json:"postal_code"`
+	Reference                  string `json:"reference"`
+	Region                     string `json:"region"`
+	Scheme                     string `json:"scheme"`
 }
@@ -0,0 +1,105 @@
+package gocardless
+
+import (
+	"time"
+)
+
+// SubscriptionStatus describes the state of a Subscription
+type SubscriptionStatus string
+
+const (
+	// SubscriptionPendingCustomerApproval means the subscription is waiting for customer approval before becoming
+	// active
+	SubscriptionPendingCustomerApproval SubscriptionStatus = `pending_customer_approval`
+	// SubscriptionCustomerApprovalDenied means the customer did not approve the subscription
+	SubscriptionCustomerApprovalDenied SubscriptionStatus = `customer_approval_denied`
+	// SubscriptionActive means the subscription has been created and will create payments
+	SubscriptionActive SubscriptionStatus = `active`
+	// SubscriptionFinished means all of the payments scheduled for creation under this subscription have been created
+	SubscriptionFinished SubscriptionStatus = `finished`
+	// SubscriptionCancelled means the subscription has been cancelled and will no longer create payments
+	SubscriptionCancelled SubscriptionStatus = `cancelled`
+	// SubscriptionPaused means the subscription has been paused and will not create payments until it is resumed
+	SubscriptionPaused SubscriptionStatus = `paused`
+)
+
+// IntervalUnit is the unit of time between payments created by a Subscription or InstalmentSchedule
+type IntervalUnit string
+
+const (
+	// IntervalWeekly creates a payment every Interval weeks
+	IntervalWeekly IntervalUnit = `weekly`
+	// IntervalMonthly creates a payment every Interval months
+	IntervalMonthly IntervalUnit = `monthly`
+	// IntervalYearly creates a payment every Interval years
+	IntervalYearly IntervalUnit = `yearly`
+)
+
+// Subscription creates Payments against a Mandate according to a schedule
+type Subscription struct {
+	// ID is a unique identifier, beginning with “SB”.
+	ID string `json:"id,omitempty"`
+	// Amount is the amount in minor unit (e.g. pence in GBP, cents in EUR).
+	Amount int `json:"amount,omitempty"`
+	// AppFee is the amount to be deducted from each payment as the OAuth app’s fee, in minor unit.
+	AppFee int `json:"app_fee,omitempty"`
+	// Count is the total number of payments that should be taken by this subscription.
+	Count int `json:"count,omitempty"`
+	// CreatedAt is a fixed timestamp, recording when the subscription was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Currency is the ISO 4217 currency code, such as “GBP” or “EUR”.
+	Currency string `json:"currency,omitempty"`
+	// DayOfMonth is the day of the month, from 1 to 28, on which to charge. As a special case -1 may be used to
+	// charge on the last day of the month.
+	DayOfMonth int `json:"day_of_month,omitempty"`
+	// EndDate is the date, in the format YYYY-MM-DD, on or after which no further payments should be created.
+	EndDate string `json:"end_date,omitempty"`
+	// Interval is the number of IntervalUnits between customer charge dates. Defaults to 1.
+	Interval int `json:"interval,omitempty"`
+	// IntervalUnit is the unit of time between customer charge dates.
+	IntervalUnit IntervalUnit `json:"interval_unit,omitempty"`
+	// Metadata is a key-value store of custom data. Up to 3 keys are permitted, with key names up to 50
+	// characters and values up to 500 characters.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Month is the name of the month, such as “january”, in which to charge customers if IntervalUnit is yearly.
+	Month string `json:"month,omitempty"`
+	// Name is an optional name for the subscription. This will be set as the description on each payment created.
+	Name string `json:"name,omitempty"`
+	// PaymentReference is an optional payment reference that will appear on your customer’s bank statement.
+	PaymentReference string `json:"payment_reference,omitempty"`
+	// RetryIfPossible will cause failed payments created by the subscription to be retried automatically when set.
+	RetryIfPossible bool `json:"retry_if_possible,omitempty"`
+	// StartDate is the date, in the format YYYY-MM-DD, on which the first payment should be charged.
+	StartDate string `json:"start_date,omitempty"`
+	// Status is the current state of the subscription.
+	Status SubscriptionStatus `json:"status,omitempty"`
+	// UpcomingPayments is up to 10 upcoming payments with their amounts and charge dates, as returned by the API.
+	UpcomingPayments []*UpcomingPayment `json:"upcoming_payments,omitempty"`
+	// Links holds the IDs of the resources the subscription is associated with
+	Links *SubscriptionLinks `json:"links,omitempty"`
+}
+
+// UpcomingPayment is a payment which will be created by a Subscription in the future
+type UpcomingPayment struct {
+	// Amount is the amount of this payment, in minor unit.
+	Amount int `json:"amount"`
+	// ChargeDate is the date, in the format YYYY-MM-DD, on which this payment will be charged.
+	ChargeDate string `json:"charge_date"`
+}
+
+// SubscriptionLinks holds the IDs of the resources linked to a Subscription
+type SubscriptionLinks struct {
+	// Mandate is the ID of the mandate against which payments will be collected.
+	Mandate string `json:"mandate,omitempty"`
+}
+
+// SubscriptionListOptions holds the parameters used to filter the results of ListSubscriptions
+type SubscriptionListOptions struct {
+	ListOptions
+	// Customer restricts the results to subscriptions for this customer ID
+	Customer string `url:"customer"`
+	// Mandate restricts the results to subscriptions against this mandate ID
+	Mandate string `url:"mandate"`
+	// Status restricts the results to subscriptions in this state
+	Status SubscriptionStatus `url:"status"`
+}
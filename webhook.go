@@ -0,0 +1,69 @@
+package gocardless
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	// WebhookSignatureHeader is the header containing the signature of a webhook sent by GoCardless
+	WebhookSignatureHeader = `Webhook-Signature`
+	// MaxWebhookBodySize is the largest webhook body, in bytes, read by ParseWebhookRequest. GoCardless sends at most
+	// 250 events in a webhook, which is well within this limit
+	MaxWebhookBodySize = 5 << 20
+)
+
+// webhookWrapper is a utility struct used to unwrap the body of a webhook
+type webhookWrapper struct {
+	Events []*Event `json:"events"`
+}
+
+// VerifyWebhookSignature reports whether signature, the hex encoded value of the Webhook-Signature header, is the
+// HMAC-SHA256 of body using the webhook endpoint secret. The comparison is made in constant time
+func VerifyWebhookSignature(secret string, body []byte, signature string) bool {
+	received, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+// ParseWebhook verifies the signature of a webhook body and returns the events it contains. An
+// *InvalidWebhookSignatureError is returned when the signature does not match, and a *MalformedWebhookError when the
+// body is not a list of events or contains an event which is null
+func ParseWebhook(secret string, body []byte, signature string) ([]*Event, error) {
+	if !VerifyWebhookSignature(secret, body, signature) {
+		return nil, &InvalidWebhookSignatureError{}
+	}
+
+	wrapper := &webhookWrapper{}
+	if err := json.Unmarshal(body, wrapper); err != nil {
+		return nil, &MalformedWebhookError{err}
+	}
+	for _, event := range wrapper.Events {
+		if event == nil {
+			return nil, &MalformedWebhookError{errors.New(`the webhook contains a null event`)}
+		}
+	}
+	return wrapper.Events, nil
+}
+
+// ParseWebhookRequest reads the body and Webhook-Signature header of a webhook request and parses it as ParseWebhook.
+// The body is read before its signature can be checked, so reading stops after MaxWebhookBodySize bytes and an
+// *http.MaxBytesError is returned for a larger body
+func ParseWebhookRequest(secret string, req *http.Request) ([]*Event, error) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, req.Body, MaxWebhookBodySize))
+	if err != nil {
+		return nil, err
+	}
+	return ParseWebhook(secret, body, req.Header.Get(WebhookSignatureHeader))
+}
@@ -0,0 +1,192 @@
+package gocardless
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const (
+	// StatusInvalidToken is the status code GoCardless expects in response to a webhook with an invalid signature
+	StatusInvalidToken = 498
+)
+
+// WebhookEventFunc is called by a WebhookHandler for each event it receives that matches its registration. Returning
+// an error causes the webhook to be rejected, so that GoCardless will send it again later
+type WebhookEventFunc func(*Event) error
+
+// WebhookHandler is an http.Handler which verifies the webhooks sent by GoCardless and passes each event to the
+// functions registered for its resource type and action. It can be mounted on any router
+//
+//     handler := NewWebhookHandler(secret)
+//     handler.OnMandate(`cancelled`, func(event *Event) error {
+//         return cancelMembership(event.Links.Mandate)
+//     })
+//     http.Handle(`/webhooks`, handler)
+//
+// The handler responds with StatusInvalidToken when the signature is invalid, 400 when the body is malformed, 409
+// when an event is being handled by another process using the same EventStore, 500 when a registered function returns
+// an error and 204 once every event has been handled
+type WebhookHandler struct {
+	secret string
+	store  EventStore
+	mutex  sync.RWMutex
+	funcs  map[ResourceType]map[string][]WebhookEventFunc
+}
+
+// NewWebhookHandler returns a WebhookHandler verifying webhooks with the secret of the webhook endpoint
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		secret: secret,
+		funcs:  map[ResourceType]map[string][]WebhookEventFunc{},
+	}
+}
+
+// On registers fn to be called for events of the resource type with the given action, such as “cancelled”. When the
+// action is blank fn is called for every event of the resource type. Functions are called in the order they were
+// registered
+func (h *WebhookHandler) On(resourceType ResourceType, action string, fn WebhookEventFunc) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.funcs[resourceType] == nil {
+		h.funcs[resourceType] = map[string][]WebhookEventFunc{}
+	}
+	h.funcs[resourceType][action] = append(h.funcs[resourceType][action], fn)
+}
+
+// OnBillingRequest registers fn to be called for billing request events with the given action
+func (h *WebhookHandler) OnBillingRequest(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeBillingRequests, action, fn)
+}
+
+// OnCreditor registers fn to be called for creditor events with the given action
+func (h *WebhookHandler) OnCreditor(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeCreditors, action, fn)
+}
+
+// OnInstalmentSchedule registers fn to be called for instalment schedule events with the given action
+func (h *WebhookHandler) OnInstalmentSchedule(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeInstalmentSchedules, action, fn)
+}
+
+// OnMandate registers fn to be called for mandate events with the given action
+func (h *WebhookHandler) OnMandate(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeMandates, action, fn)
+}
+
+// OnPayment registers fn to be called for payment events with the given action
+func (h *WebhookHandler) OnPayment(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypePayments, action, fn)
+}
+
+// OnPayout registers fn to be called for payout events with the given action
+func (h *WebhookHandler) OnPayout(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypePayouts, action, fn)
+}
+
+// OnRefund registers fn to be called for refund events with the given action
+func (h *WebhookHandler) OnRefund(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeRefunds, action, fn)
+}
+
+// OnSubscription registers fn to be called for subscription events with the given action
+func (h *WebhookHandler) OnSubscription(action string, fn WebhookEventFunc) {
+	h.On(ResourceTypeSubscriptions, action, fn)
+}
+
+// UseEventStore sets the store used to ensure each event is only handled once, however many times it is delivered.
+// Without a store every delivery of an event is passed to the registered functions. An event which has been completed
+// is skipped, while an event claimed by another process is rejected with 409 so that GoCardless resends the webhook
+// until that process has completed the event or released it
+func (h *WebhookHandler) UseEventStore(store EventStore) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.store = store
+}
+
+// ServeHTTP verifies the webhook and dispatches its events. Events are handled in the order they appear in the
+// webhook, and handling stops at the first error
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set(`Allow`, http.MethodPost)
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	events, err := ParseWebhookRequest(h.secret, req)
+	if err != nil {
+		var signatureErr *InvalidWebhookSignatureError
+		var malformedErr *MalformedWebhookError
+		var tooLargeErr *http.MaxBytesError
+		switch {
+		case errors.As(err, &signatureErr):
+			w.WriteHeader(StatusInvalidToken)
+		case errors.As(err, &malformedErr):
+			w.WriteHeader(http.StatusBadRequest)
+		case errors.As(err, &tooLargeErr):
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		return
+	}
+
+	for _, event := range events {
+		if err := h.handle(event); err != nil {
+			var inProgressErr *EventInProgressError
+			if errors.As(err, &inProgressErr) {
+				w.WriteHeader(http.StatusConflict)
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handle dispatches the event, using the EventStore when one is set to skip events which have already been handled.
+// An *EventInProgressError is returned when another process holds the claim on the event
+func (h *WebhookHandler) handle(event *Event) error {
+	h.mutex.RLock()
+	store := h.store
+	h.mutex.RUnlock()
+
+	if store == nil {
+		return h.dispatch(event)
+	}
+
+	claimed, err := store.Claim(event.ID)
+	if err != nil || !claimed {
+		return err
+	}
+	if err := h.dispatch(event); err != nil {
+		if releaseErr := store.Release(event.ID); releaseErr != nil {
+			return fmt.Errorf(`%w; releasing event %s: %v`, err, event.ID, releaseErr)
+		}
+		return err
+	}
+	return store.Complete(event.ID)
+}
+
+// dispatch calls the functions registered for the event's action, followed by those registered for every action
+func (h *WebhookHandler) dispatch(event *Event) error {
+	h.mutex.RLock()
+	actions := h.funcs[event.ResourceType]
+	var funcs []WebhookEventFunc
+	funcs = append(funcs, actions[event.Action]...)
+	if event.Action != `` {
+		funcs = append(funcs, actions[``]...)
+	}
+	h.mutex.RUnlock()
+
+	for _, fn := range funcs {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package gocardless
+
+import (
+	"testing"
+
+	"bytes"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	Convey(`Given I have a webhook handler`, t, func() {
+		handler := NewWebhookHandler(webhookSecret)
+
+		var cancelledMandates []string
+		var confirmedPayments []string
+		var mandateActions []string
+		handler.OnMandate(`cancelled`, func(event *Event) error {
+			cancelledMandates = append(cancelledMandates, event.Links.Mandate)
+			return nil
+		})
+		handler.OnMandate(``, func(event *Event) error {
+			mandateActions = append(mandateActions, event.Action)
+			return nil
+		})
+
+		send := func(body []byte, signature string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, `/webhooks`, bytes.NewReader(body))
+			req.Header.Set(WebhookSignatureHeader, signature)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		Convey(`And I have registered a payment function which succeeds`, func() {
+			handler.OnPayment(`confirmed`, func(event *Event) error {
+				confirmedPayments = append(confirmedPayments, event.Links.Payment)
+				return nil
+			})
+
+			Convey(`When a correctly signed webhook is received`, func() {
+				body := []byte(webhookBody)
+				response := send(body, signWebhook(webhookSecret, body))
+
+				Convey(`Then the response status will be 204`, func() {
+					So(response.Code, ShouldEqual, http.StatusNoContent)
+				})
+
+				Convey(`Then the events will be passed to the matching functions`, func() {
+					So(cancelledMandates, ShouldResemble, []string{`MD123`})
+					So(confirmedPayments, ShouldResemble, []string{`PM123`})
+				})
+
+				Convey(`Then functions registered without an action will receive every event of the type`, func() {
+					So(mandateActions, ShouldResemble, []string{`cancelled`})
+				})
+			})
+
+			Convey(`When a webhook with an invalid signature is received`, func() {
+				response := send([]byte(webhookBody), signWebhook(`another-secret`, []byte(webhookBody)))
+
+				Convey(`Then the response status will be 498`, func() {
+					So(response.Code, ShouldEqual, StatusInvalidToken)
+				})
+
+				Convey(`Then no functions will be called`, func() {
+					So(cancelledMandates, ShouldBeEmpty)
+					So(confirmedPayments, ShouldBeEmpty)
+				})
+			})
+
+			Convey(`When a correctly signed webhook with a malformed body is received`, func() {
+				body := []byte(`not json`)
+				response := send(body, signWebhook(webhookSecret, body))
+
+				Convey(`Then the response status will be 400`, func() {
+					So(response.Code, ShouldEqual, http.StatusBadRequest)
+				})
+			})
+
+			Convey(`When a correctly signed webhook containing a null event is received`, func() {
+				body := []byte(`{"events": [null]}`)
+				response := send(body, signWebhook(webhookSecret, body))
+
+				Convey(`Then the response status will be 400`, func() {
+					So(response.Code, ShouldEqual, http.StatusBadRequest)
+				})
+			})
+
+			Convey(`When a webhook with a body larger than MaxWebhookBodySize is received`, func() {
+				body := bytes.Repeat([]byte(` `), MaxWebhookBodySize+1)
+				response := send(body, signWebhook(webhookSecret, body))
+
+				Convey(`Then the response status will be 413`, func() {
+					So(response.Code, ShouldEqual, http.StatusRequestEntityTooLarge)
+				})
+
+				Convey(`Then no functions will be called`, func() {
+					So(cancelledMandates, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey(`And I have registered a payment function which fails`, func() {
+			handler.OnPayment(`confirmed`, func(event *Event) error {
+				return errors.New(`database unavailable`)
+			})
+
+			Convey(`When a correctly signed webhook is received`, func() {
+				body := []byte(webhookBody)
+				response := send(body, signWebhook(webhookSecret, body))
+
+				Convey(`Then the response status will be 500 so the webhook is retried`, func() {
+					So(response.Code, ShouldEqual, http.StatusInternalServerError)
+				})
+			})
+		})
+
+		Convey(`When a GET request is received`, func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, `/webhooks`, nil))
+
+			Convey(`Then the response status will be 405`, func() {
+				So(recorder.Code, ShouldEqual, http.StatusMethodNotAllowed)
+			})
+		})
+	})
+}
+
+func TestWebhookHandlerWithServer(t *testing.T) {
+	Convey(`Given I have a webhook handler mounted on a server`, t, func() {
+		handler := NewWebhookHandler(webhookSecret)
+		received := 0
+		handler.On(ResourceTypePayments, `confirmed`, func(event *Event) error {
+			received++
+			return nil
+		})
+
+		mux := http.NewServeMux()
+		mux.Handle(`/webhooks`, handler)
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		Convey(`When GoCardless sends a webhook`, func() {
+			body := []byte(webhookBody)
+			req, _ := http.NewRequest(http.MethodPost, srv.URL+`/webhooks`, bytes.NewReader(body))
+			req.Header.Set(WebhookSignatureHeader, signWebhook(webhookSecret, body))
+			resp, err := http.DefaultClient.Do(req)
+
+			Convey(`Then the webhook will be accepted`, func() {
+				So(err, ShouldBeNil)
+				So(resp.StatusCode, ShouldEqual, http.StatusNoContent)
+				So(received, ShouldEqual, 1)
+			})
+		})
+	})
+}
@@ -0,0 +1,167 @@
+package gocardless
+
+import (
+	"testing"
+
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"net/http"
+	"net/http/httptest"
+)
+
+const webhookSecret = `ElnDsA9nfvFhKk8aqjxu8W7GVGjJw7rX`
+
+const webhookBody = `{
+	"events": [
+		{
+			"id": "EV123",
+			"created_at": "2014-08-04T12:00:00.000Z",
+			"action": "cancelled",
+			"resource_type": "mandates",
+			"links": {
+				"mandate": "MD123"
+			},
+			"details": {
+				"origin": "bank",
+				"cause": "bank_account_disabled",
+				"description": "Your customer closed their bank account.",
+				"scheme": "bacs",
+				"reason_code": "ADDACS-B"
+			}
+		},
+		{
+			"id": "EV456",
+			"created_at": "2014-08-04T12:00:00.000Z",
+			"action": "confirmed",
+			"resource_type": "payments",
+			"links": {
+				"payment": "PM123"
+			}
+		}
+	]
+}`
+
+func signWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseWebhook(t *testing.T) {
+	Convey(`Given I have a webhook body`, t, func() {
+		body := []byte(webhookBody)
+
+		Convey(`And I have a valid signature`, func() {
+			signature := signWebhook(webhookSecret, body)
+
+			Convey(`When I parse the webhook`, func() {
+				events, err := ParseWebhook(webhookSecret, body, signature)
+
+				Convey(`Then the error will be nil`, func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey(`Then the events will be returned in order`, func() {
+					So(events, ShouldHaveLength, 2)
+					So(events[0].ID, ShouldEqual, `EV123`)
+					So(events[0].ResourceType, ShouldEqual, ResourceTypeMandates)
+					So(events[0].Action, ShouldEqual, `cancelled`)
+					So(events[0].Links.Mandate, ShouldEqual, `MD123`)
+					So(events[0].Details.Cause, ShouldEqual, `bank_account_disabled`)
+					So(events[1].ResourceType, ShouldEqual, ResourceTypePayments)
+					So(events[1].Links.Payment, ShouldEqual, `PM123`)
+				})
+			})
+		})
+
+		Convey(`And I have a signature made with a different secret`, func() {
+			signature := signWebhook(`another-secret`, body)
+
+			Convey(`When I parse the webhook`, func() {
+				events, err := ParseWebhook(webhookSecret, body, signature)
+
+				Convey(`Then an InvalidWebhookSignatureError will be returned`, func() {
+					So(events, ShouldBeNil)
+					So(err, ShouldHaveSameTypeAs, &InvalidWebhookSignatureError{})
+				})
+			})
+		})
+
+		Convey(`And I have a signature which is not hex encoded`, func() {
+			Convey(`When I parse the webhook`, func() {
+				_, err := ParseWebhook(webhookSecret, body, `not-a-signature`)
+
+				Convey(`Then an InvalidWebhookSignatureError will be returned`, func() {
+					So(err, ShouldHaveSameTypeAs, &InvalidWebhookSignatureError{})
+				})
+			})
+		})
+	})
+
+	Convey(`Given I have a correctly signed body which is not JSON`, t, func() {
+		body := []byte(`{"events": [`)
+		signature := signWebhook(webhookSecret, body)
+
+		Convey(`When I parse the webhook`, func() {
+			_, err := ParseWebhook(webhookSecret, body, signature)
+
+			Convey(`Then a MalformedWebhookError will be returned`, func() {
+				var malformed *MalformedWebhookError
+				So(errors.As(err, &malformed), ShouldBeTrue)
+				So(malformed.Err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey(`Given I have a correctly signed body containing a null event`, t, func() {
+		body := []byte(`{"events": [null]}`)
+		signature := signWebhook(webhookSecret, body)
+
+		Convey(`When I parse the webhook`, func() {
+			events, err := ParseWebhook(webhookSecret, body, signature)
+
+			Convey(`Then a MalformedWebhookError will be returned`, func() {
+				var malformed *MalformedWebhookError
+				So(errors.As(err, &malformed), ShouldBeTrue)
+				So(events, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestParseWebhookRequest(t *testing.T) {
+	Convey(`Given I have a signed webhook request`, t, func() {
+		body := []byte(webhookBody)
+		req := httptest.NewRequest(`POST`, `/webhooks`, bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, signWebhook(webhookSecret, body))
+
+		Convey(`When I parse the request`, func() {
+			events, err := ParseWebhookRequest(webhookSecret, req)
+
+			Convey(`Then the events will be returned`, func() {
+				So(err, ShouldBeNil)
+				So(events, ShouldHaveLength, 2)
+			})
+		})
+	})
+
+	Convey(`Given I have a webhook request with a body larger than MaxWebhookBodySize`, t, func() {
+		body := bytes.Repeat([]byte(` `), MaxWebhookBodySize+1)
+		req := httptest.NewRequest(`POST`, `/webhooks`, bytes.NewReader(body))
+		req.Header.Set(WebhookSignatureHeader, signWebhook(webhookSecret, body))
+
+		Convey(`When I parse the request`, func() {
+			events, err := ParseWebhookRequest(webhookSecret, req)
+
+			Convey(`Then an *http.MaxBytesError will be returned`, func() {
+				var tooLarge *http.MaxBytesError
+				So(errors.As(err, &tooLarge), ShouldBeTrue)
+				So(events, ShouldBeNil)
+			})
+		})
+	})
+}